@@ -0,0 +1,169 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"golang.org/x/tools/go/packages"
+
+	"lesiw.io/moxie/gen"
+)
+
+const configfile = "moxie.json"
+
+// A project is the contents of a moxie.json file.
+type project struct {
+	// Tags are build tags to load packages with, as with go build -tags.
+	Tags []string `json:"tags,omitempty"`
+
+	Packages []struct {
+		// Path is the directory of the package, relative to the file.
+		Path string `json:"path"`
+		// Mocks are the mocks to generate in the package.
+		// Template directories are relative to the package.
+		Mocks []gen.Config `json:"mocks"`
+	} `json:"packages"`
+}
+
+// findconfig returns the path of moxie.json at the root of the module
+// containing dir, or an empty string if there is none.
+func findconfig(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	for {
+		_, err := os.Stat(filepath.Join(dir, "go.mod"))
+		if err == nil {
+			break
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return "", err
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
+		}
+		dir = parent
+	}
+	file := filepath.Join(dir, configfile)
+	if _, err := os.Stat(file); errors.Is(err, fs.ErrNotExist) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+	return file, nil
+}
+
+// readproject reads the moxie.json at file.
+func readproject(file string) (*project, error) {
+	buf, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read '%s': %w", file, err)
+	}
+	var proj project
+	dec := json.NewDecoder(bytes.NewReader(buf))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&proj); err != nil {
+		return nil, fmt.Errorf("bad config: %s: %w", file, err)
+	}
+	return &proj, nil
+}
+
+// generateall generates every mock listed in the moxie.json at file,
+// loading packages with tags in addition to those in the file.
+func generateall(file string, tags []string) error {
+	srcs, err := renderall(file, tags)
+	if err != nil {
+		return err
+	}
+	for _, fname := range slices.Sorted(maps.Keys(srcs)) {
+		if err := os.WriteFile(fname, srcs[fname], 0644); err != nil {
+			return fmt.Errorf("failed to write '%s': %w", fname, err)
+		}
+	}
+	return nil
+}
+
+// renderall renders every mock listed in the moxie.json at file, returning
+// their sources keyed by absolute file name. Packages are loaded with tags
+// in addition to those in the file.
+//
+// Packages are loaded once, and mocks are rendered in parallel per package.
+func renderall(file string, tags []string) (map[string][]byte, error) {
+	proj, err := readproject(file)
+	if err != nil {
+		return nil, err
+	}
+	root := filepath.Dir(file)
+	tags = slices.Concat(proj.Tags, tags)
+
+	patterns := make([]string, 0, len(proj.Packages))
+	for _, pkg := range proj.Packages {
+		path := filepath.ToSlash(filepath.Clean(pkg.Path))
+		patterns = append(patterns, "./"+path)
+		for _, m := range pkg.Mocks {
+			// Malformed targets are reported by gen.Generate.
+			if i := strings.LastIndex(m.Wrap, "."); i > 0 {
+				patterns = append(patterns, m.Wrap[:i])
+			}
+		}
+	}
+	pkgs, err := gen.Load(root, tags, patterns...)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		srcs = make(map[string][]byte)
+		errs []error
+	)
+	for _, pkg := range proj.Packages {
+		wg.Go(func() {
+			dir := filepath.Join(root, pkg.Path)
+			for _, m := range pkg.Mocks {
+				m.Warnf = func(format string, args ...any) {
+					warnf("%s: "+format, append([]any{pkg.Path}, args...)...)
+				}
+				src, err := rendermock(dir, pkgs, m)
+				mu.Lock()
+				if err != nil {
+					errs = append(errs, fmt.Errorf("%s: %w", pkg.Path, err))
+				} else {
+					srcs[filepath.Join(dir, m.Filename())] = src
+				}
+				mu.Unlock()
+			}
+		})
+	}
+	wg.Wait()
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	if err := gen.Check(pkgs, tags, srcs); err != nil {
+		return nil, err
+	}
+	return srcs, nil
+}
+
+func rendermock(
+	dir string, pkgs []*packages.Package, cfg gen.Config,
+) ([]byte, error) {
+	cfg.Dir = dir
+	cfg.Packages = pkgs
+	cfg.NoCheck = true // Checked together by renderall.
+	if cfg.Template != "" && !filepath.IsAbs(cfg.Template) {
+		cfg.Template = filepath.Join(dir, cfg.Template)
+	}
+	return gen.Generate(cfg)
+}
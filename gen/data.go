@@ -0,0 +1,136 @@
+package gen
+
+// Data is the data passed to templates.
+//
+// The default templates render mocks of types from the "mock" template,
+// mocks of function variables from the "func" template, and mocks of function
+// types from the "functype" template.
+type Data struct {
+	// Package is the name of the package of the generated file.
+	Package string
+
+	// Type is the name of the mocked type, function variable, or function
+	// type.
+	Type string
+
+	// Wrap is the type embedded in a struct generated for Config.Wrap,
+	// such as io.ReadWriter. It is empty if no struct is generated.
+	Wrap string
+
+	// VarType is the type of a mocked function variable,
+	// such as func() time.Time.
+	VarType string
+
+	// State reports whether the mocked type keeps its mock state in a
+	// state.State field named moxie.
+	State bool
+
+	// Fluent reports whether to generate a Mock() method.
+	Fluent bool
+
+	// Methods holds the exported methods of the mocked type.
+	// For function variables and function types, it holds one method
+	// describing the function.
+	Methods []Method
+}
+
+// A Method describes a mocked method or function.
+//
+// Types are qualified for use in the generated file, such as pkg.Int.
+type Method struct {
+	// Type is the name of the mocked type, as in Data.
+	Type string
+
+	// Name is the name of the method.
+	Name string
+
+	// Params holds the parameters of the method.
+	// The type of a variadic parameter is a slice type.
+	Params []Var
+
+	// Results holds the results of the method.
+	Results []Var
+
+	// Variadic reports whether the last parameter is variadic.
+	Variadic bool
+
+	// Context reports whether the first parameter is a context.Context.
+	Context bool
+
+	// Embed is the name of the embedded field that the method is promoted
+	// from. It is empty for proxies.
+	Embed string
+
+	// Proxy is the name of the unexported method declared on the mocked
+	// type that the method calls unless mocked, set by Config.Proxy.
+	Proxy string
+
+	// EmbedType is the type of the embedded field.
+	EmbedType string
+
+	// Nilable reports whether the embedded field is an interface, which
+	// panics with a message naming the method if it is nil and not mocked.
+	Nilable bool
+
+	// Limit is the number of calls recorded by default.
+	// It is only meaningful if Record is true.
+	Limit int
+
+	// Record reports whether Limit was set by Config.Record.
+	Record bool
+
+	// Signature is the method name with its parameters and result types,
+	// such as Read(p []byte) (int, error). For function variables and
+	// function types, the name is omitted.
+	Signature string
+
+	// Args is the list of arguments passing the parameters on to another
+	// function, such as "p, q...".
+	Args string
+
+	// CallArgs is Args without a trailing "...".
+	CallArgs string
+
+	// ParamTypes is the list of parameter types, such as "[]byte, ...int".
+	ParamTypes string
+
+	// ResultParams is the list of named results, such as "n int, err error".
+	ResultParams string
+
+	// ResultTypes is the list of result types, such as "int, error".
+	ResultTypes string
+
+	// ResultArgs is the list of result names, such as "n, err".
+	ResultArgs string
+}
+
+// A Var is a parameter or result.
+type Var struct {
+	// Name is the name of the variable in generated code.
+	// Unnamed parameters are named P0, P1, and so on,
+	// and unnamed results r0, r1, and so on.
+	Name string
+
+	// Field is the name of the variable in a call struct.
+	Field string
+
+	// Type is the type of the variable.
+	Type string
+}
+
+// ContextType returns the type of the first parameter.
+func (m Method) ContextType() string { return m.Params[0].Type }
+
+// ContextArg returns the name of the first parameter.
+func (m Method) ContextArg() string { return m.Params[0].Name }
+
+// Records returns the methods whose Limit was set by Config.Record.
+func (d Data) Records() []Method {
+	var methods []Method
+	for _, m := range d.Methods {
+		if m.Record {
+			methods = append(methods, m)
+		}
+	}
+	return methods
+}
@@ -0,0 +1,1672 @@
+// Package gen generates the mocks of lesiw.io/moxie.
+//
+// The moxie command is a thin wrapper around [Generate].
+package gen
+
+import (
+	"bytes"
+	"cmp"
+	"embed"
+	"fmt"
+	"go/ast"
+	"go/build/constraint"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"maps"
+	"os"
+	"os/exec"
+	pathpkg "path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"golang.org/x/tools/go/ast/astutil"
+	"golang.org/x/tools/go/packages"
+)
+
+// Config describes a mock to generate.
+//
+// Exactly one of Type, Wrap, Func, and FuncType must be set.
+type Config struct {
+	// Dir is the directory of the package to generate a mock for.
+	// If empty, the current directory is used.
+	//
+	// Type, Func, and FuncType are also looked up in the packages of the
+	// subdirectories of Dir, unless Packages is set.
+	Dir string `json:"-"`
+
+	// Packages holds already loaded packages to use instead of loading them,
+	// as returned by [Load]. It must include the packages in Dir and, for
+	// Wrap, the package of the wrapped type. Type, Func, and FuncType are
+	// only looked up in the packages in Dir.
+	Packages []*packages.Package `json:"-"`
+
+	// Tags are build tags to select files with, as with go build -tags,
+	// in addition to any tags set in GOFLAGS.
+	Tags []string `json:"-"`
+
+	// NoCheck skips type-checking the generated file, for callers that
+	// check several files at once with [Check].
+	NoCheck bool `json:"-"`
+
+	// Warnf, if not nil, is called to report methods that are not mocked
+	// and other problems that do not prevent generating a mock.
+	Warnf func(format string, args ...any) `json:"-"`
+
+	// Type is the name of a struct type to mock.
+	Type string `json:"type,omitempty"`
+
+	// Wrap is the import path and name of a type to embed in a generated
+	// struct, such as io.ReadWriter.
+	Wrap string `json:"wrap,omitempty"`
+
+	// Name is the name of the struct generated for Wrap.
+	// If empty, the name of the wrapped type is used.
+	Name string `json:"name,omitempty"`
+
+	// Func is the name of a package-level function variable to mock.
+	Func string `json:"func,omitempty"`
+
+	// FuncType is the name of a function type to generate a recording
+	// constructor for.
+	FuncType string `json:"functype,omitempty"`
+
+	// Record maps method names to the number of calls recorded by default.
+	// The empty method name sets the limit for all methods.
+	// A negative limit records every call. 0 disables recording.
+	// Methods without a limit record every call.
+	Record map[string]int `json:"record,omitempty"`
+
+	// Export exports the generated helpers and places them behind the moxie
+	// build constraint, so that other packages can use them.
+	Export bool `json:"export,omitempty"`
+
+	// Fluent adds a Mock() method returning a controller for each method.
+	Fluent bool `json:"fluent,omitempty"`
+
+	// Template is a directory of *.tmpl files to parse after the default
+	// templates. Each file defines a template named after it, and may also
+	// redefine templates declared by the default templates.
+	//
+	// Types are rendered by mock.tmpl, function variables by func.tmpl, and
+	// function types by functype.tmpl, each executed with a [Data]. For
+	// adding helpers without replacing a whole file, mock.tmpl declares
+	// empty "typehelpers" and "methodhelpers" blocks, executed once per type
+	// and once per method. func.tmpl and functype.tmpl declare empty
+	// "funchelpers" and "functypehelpers" blocks, executed once per file with
+	// the [Method] describing the function.
+	//
+	// Templates may call qualifier with the import path of a package to get
+	// the name to refer to it by, adding it to the imports of the file.
+	Template string `json:"template,omitempty"`
+
+	// Methods, if not empty, limits the mocked methods to those named.
+	Methods []string `json:"methods,omitempty"`
+
+	// Exclude lists methods not to mock.
+	// Calls to them go straight to the embedded type.
+	Exclude []string `json:"exclude,omitempty"`
+
+	// Pick maps the names of methods promoted ambiguously from several
+	// embedded fields of Type, which Go leaves out of its method set, to the
+	// field to delegate to. A method is generated for each, so that it can
+	// be mocked. The methods of the fields not picked, and other ambiguous
+	// methods, are not mocked.
+	Pick map[string]string `json:"pick,omitempty"`
+
+	// Proxy lists unexported methods declared on Type, such as validate,
+	// to generate exported methods for, such as Validate, that call them
+	// unless mocked. Exported methods declared on Type are not mocked, and
+	// are reported to Warnf if they hide methods of embedded fields.
+	Proxy []string `json:"proxy,omitempty"`
+
+	// Local lists import path prefixes to group after other imports, as with
+	// goimports -local. If empty, the module of the package is used.
+	Local []string `json:"local,omitempty"`
+
+	// Output is the name of the generated file.
+	// If empty, Filename chooses a name from the type.
+	Output string `json:"output,omitempty"`
+}
+
+// LoadMode is the mode [Load] loads packages with.
+const LoadMode = packages.NeedName | packages.NeedFiles |
+	packages.NeedCompiledGoFiles | packages.NeedTypes | packages.NeedSyntax |
+	packages.NeedTypesInfo | packages.NeedTypesSizes | packages.NeedModule |
+	packages.NeedForTest
+
+// Load loads the packages matching patterns in dir, with their tests,
+// for use in [Config]. Files are selected as if built with tags, in addition
+// to any tags set in GOFLAGS.
+func Load(
+	dir string, tags []string, patterns ...string,
+) ([]*packages.Package, error) {
+	flags, err := BuildFlags(tags...)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &packages.Config{
+		Dir:        dir,
+		Mode:       LoadMode,
+		Tests:      true,
+		BuildFlags: flags,
+	}
+	pkgs, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load packages: %w", err)
+	}
+	return pkgs, nil
+}
+
+// Check type-checks generated files against the packages they belong to,
+// given their sources keyed by absolute file name. Errors in other files of
+// those packages are ignored.
+//
+// Files are checked against pkgs, as returned by [Load], if their package is
+// among them and already depends on every package they import. Other files
+// are checked by loading their packages as by Load with tags, along with the
+// files behind the moxie build constraint, as written for Config.Export.
+func Check(
+	pkgs []*packages.Package, tags []string, srcs map[string][]byte,
+) error {
+	var (
+		errs   []string
+		loaded = make(map[*packages.Package]map[string][]byte)
+		rest   = make(map[string][]byte)
+	)
+	for fname, src := range srcs {
+		pkg := checkpkg(pkgs, fname, src)
+		if pkg == nil {
+			rest[fname] = src
+			continue
+		}
+		if loaded[pkg] == nil {
+			loaded[pkg] = make(map[string][]byte)
+		}
+		loaded[pkg][fname] = src
+	}
+	for pkg, srcs := range loaded {
+		perrs, ok := checkloaded(pkg, srcs)
+		if !ok {
+			maps.Copy(rest, srcs)
+			continue
+		}
+		errs = append(errs, perrs...)
+	}
+	lerrs, err := checkload(tags, rest)
+	if err != nil {
+		return err
+	}
+	for _, e := range lerrs {
+		if !slices.Contains(errs, e) {
+			errs = append(errs, e)
+		}
+	}
+	if len(errs) > 0 {
+		slices.Sort(errs)
+		return fmt.Errorf("bad generated code:\n\t%s",
+			strings.Join(errs, "\n\t"))
+	}
+	return nil
+}
+
+// checkpkg returns the package in pkgs that the generated file fname belongs
+// to, preferring the variant built for tests, or nil if there is none.
+func checkpkg(
+	pkgs []*packages.Package, fname string, src []byte,
+) *packages.Package {
+	file, err := parser.ParseFile(token.NewFileSet(), fname, src,
+		parser.PackageClauseOnly)
+	if err != nil {
+		return nil
+	}
+	var found *packages.Package
+	for _, pkg := range pkgs {
+		if pkg.Dir != filepath.Dir(fname) || pkg.Name != file.Name.Name ||
+			strings.HasSuffix(pkg.PkgPath, ".test") {
+			continue
+		}
+		switch {
+		case pkg.ForTest != "":
+			return pkg
+		case !strings.HasSuffix(fname, "_test.go"):
+			found = pkg
+		}
+	}
+	return found
+}
+
+// checkloaded type-checks the generated files in srcs with the other files of
+// pkg, returning the errors in srcs. It reports false if pkg was loaded
+// without syntax, or if a file imports a package that pkg does not depend on.
+func checkloaded(
+	pkg *packages.Package, srcs map[string][]byte,
+) ([]string, bool) {
+	if pkg.Types == nil || len(pkg.Syntax) != len(pkg.CompiledGoFiles) {
+		return nil, false
+	}
+	deps := make(map[string]*types.Package)
+	var walk func(*types.Package)
+	walk = func(p *types.Package) {
+		for _, imp := range p.Imports() {
+			if deps[imp.Path()] == nil {
+				deps[imp.Path()] = imp
+				walk(imp)
+			}
+		}
+	}
+	walk(pkg.Types)
+	deps["unsafe"] = types.Unsafe
+
+	var files []*ast.File
+	for _, file := range pkg.Syntax {
+		if _, ok := srcs[pkg.Fset.File(file.Pos()).Name()]; !ok {
+			files = append(files, file)
+		}
+	}
+	var errs []string
+	for _, fname := range slices.Sorted(maps.Keys(srcs)) {
+		file, err := parser.ParseFile(pkg.Fset, fname, srcs[fname],
+			parser.SkipObjectResolution)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		for _, spec := range file.Imports {
+			path, err := strconv.Unquote(spec.Path.Value)
+			if err != nil || deps[path] == nil {
+				return nil, false
+			}
+		}
+		files = append(files, file)
+	}
+	if len(errs) > 0 {
+		return errs, true
+	}
+	cfg := &types.Config{
+		Importer: importerfunc(func(path string) (*types.Package, error) {
+			return deps[path], nil
+		}),
+		Sizes: pkg.TypesSizes,
+		Error: func(err error) {
+			terr, ok := err.(types.Error)
+			if !ok {
+				return
+			}
+			pos := terr.Fset.Position(terr.Pos)
+			if _, ok := srcs[pos.Filename]; ok && !slices.Contains(errs,
+				err.Error()) {
+				errs = append(errs, err.Error())
+			}
+		},
+	}
+	_, _ = cfg.Check(pkg.PkgPath, pkg.Fset, files, nil)
+	return errs, true
+}
+
+// An importerfunc imports packages by calling itself.
+type importerfunc func(path string) (*types.Package, error)
+
+func (f importerfunc) Import(path string) (*types.Package, error) {
+	return f(path)
+}
+
+// checkload type-checks the generated files in srcs by loading their
+// packages with tags and the moxie tag, returning the errors in srcs.
+func checkload(tags []string, srcs map[string][]byte) ([]string, error) {
+	var dirs []string
+	for fname := range srcs {
+		if dir := filepath.Dir(fname); !slices.Contains(dirs, dir) {
+			dirs = append(dirs, dir)
+		}
+	}
+	if len(dirs) == 0 {
+		return nil, nil
+	}
+	flags, err := BuildFlags(append(slices.Clone(tags), "moxie")...)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &packages.Config{
+		Dir:        dirs[0],
+		Mode:       LoadMode,
+		Tests:      true,
+		BuildFlags: flags,
+		Overlay:    srcs,
+	}
+	pkgs, err := packages.Load(cfg, dirs...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load packages: %w", err)
+	}
+	generated := func(pos string) bool {
+		for fname := range srcs {
+			if strings.HasPrefix(pos, fname+":") {
+				return true
+			}
+		}
+		return false
+	}
+	var errs []string
+	for _, pkg := range pkgs {
+		for _, e := range pkg.Errors {
+			if generated(e.Pos) && !slices.Contains(errs, e.Error()) {
+				errs = append(errs, e.Error())
+			}
+		}
+	}
+	return errs, nil
+}
+
+// BuildFlags returns the flags for the go command to build with tags and the
+// tags set in GOFLAGS, which would otherwise be replaced.
+func BuildFlags(tags ...string) ([]string, error) {
+	out, err := exec.Command("go", "env", "GOFLAGS").Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read GOFLAGS: %w", err)
+	}
+	var envtags []string
+	for _, flag := range strings.Fields(string(out)) {
+		name, value, _ := strings.Cut(strings.TrimLeft(flag, "-"), "=")
+		if name == "tags" {
+			envtags = append(envtags, strings.Split(value, ",")...)
+		}
+	}
+	var all []string
+	for _, tag := range slices.Concat(envtags, tags) {
+		if tag != "" && !slices.Contains(all, tag) {
+			all = append(all, tag)
+		}
+	}
+	if len(all) == 0 {
+		return nil, nil
+	}
+	return []string{"-tags=" + strings.Join(all, ",")}, nil
+}
+
+// Filename returns the name of the file that the mock described by cfg is
+// written to: Output if set, or else a name derived from the type.
+func (cfg Config) Filename() string {
+	if cfg.Output != "" {
+		return cfg.Output
+	}
+	var name string
+	switch {
+	case cfg.Wrap != "" && cfg.Name != "":
+		name = snakecase(cfg.Name)
+	case cfg.Wrap != "":
+		name = snakecase(cfg.Wrap[strings.LastIndex(cfg.Wrap, ".")+1:])
+	case cfg.Func != "":
+		name = "func_" + snakecase(cfg.Func)
+	case cfg.FuncType != "":
+		name = snakecase(cfg.FuncType)
+	default:
+		name = snakecase(cfg.Type)
+	}
+	if cfg.Export {
+		return "mock_" + name + ".go"
+	}
+	return "mock_" + name + "_test.go"
+}
+
+func (cfg Config) validate() error {
+	var modes int
+	for _, s := range []string{cfg.Type, cfg.Wrap, cfg.Func, cfg.FuncType} {
+		if s != "" {
+			modes++
+		}
+	}
+	switch {
+	case modes < 1:
+		return fmt.Errorf("bad config: no type provided")
+	case modes > 1:
+		return fmt.Errorf(
+			"bad config: set only one of Type, Wrap, Func, and FuncType")
+	case cfg.Name != "" && cfg.Wrap == "":
+		return fmt.Errorf("bad config: Name requires Wrap")
+	case len(cfg.Record) > 0 && (cfg.Func != "" || cfg.FuncType != ""):
+		return fmt.Errorf("bad config: Func and FuncType record every call")
+	case cfg.Fluent && (cfg.Func != "" || cfg.FuncType != ""):
+		return fmt.Errorf("bad config: Fluent requires Type or Wrap")
+	case len(cfg.Pick) > 0 && cfg.Type == "":
+		return fmt.Errorf("bad config: Pick requires Type")
+	case len(cfg.Proxy) > 0 && cfg.Type == "":
+		return fmt.Errorf("bad config: Proxy requires Type")
+	}
+	return nil
+}
+
+// Generate returns the formatted source of the mock described by cfg.
+func Generate(cfg Config) ([]byte, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	if cfg.Dir == "" {
+		cfg.Dir = "."
+	}
+	g := &generator{
+		cfg: cfg,
+		imports: map[string]string{
+			"lesiw.io/moxie/mock": "mock",
+			"testing":             "testing",
+		},
+		names: map[string]string{
+			"lesiw.io/moxie/mock": "mock",
+			"testing":             "testing",
+		},
+	}
+	g.pkgs = cfg.Packages
+	if g.pkgs == nil {
+		patterns := []string{"./..."}
+		if cfg.Wrap != "" {
+			path, _, err := splitwrap(cfg.Wrap)
+			if err != nil {
+				return nil, err
+			}
+			patterns = []string{".", path}
+		}
+		var err error
+		if g.pkgs, err = Load(cfg.Dir, cfg.Tags, patterns...); err != nil {
+			return nil, err
+		}
+	}
+	var err error
+	if g.dir, err = filepath.Abs(cfg.Dir); err != nil {
+		return nil, fmt.Errorf("bad dir: %w", err)
+	}
+	if cfg.Wrap != "" {
+		return g.wrap()
+	}
+	name := cmp.Or(cfg.Func, cfg.FuncType, cfg.Type)
+
+	for _, pkg := range g.pkgs {
+		if pkg.Dir != g.dir && (cfg.Packages != nil ||
+			!strings.HasPrefix(pkg.Dir, g.dir+string(filepath.Separator))) {
+			continue
+		}
+		obj := pkg.Types.Scope().Lookup(name)
+		if obj == nil {
+			continue
+		}
+		g.setpkg(pkg)
+		if g.build, err = buildconstraint(pkg, obj); err != nil {
+			return nil, err
+		}
+		switch {
+		case cfg.Func != "":
+			return g.generatefunc(obj)
+		case cfg.FuncType != "":
+			return g.generatefunctype(obj)
+		}
+		return g.generate(pkg, obj.Type(), "")
+	}
+	switch {
+	case cfg.Func != "":
+		return nil, fmt.Errorf("bad func: %s", name)
+	case cfg.FuncType != "":
+		return nil, fmt.Errorf("bad functype: %s", name)
+	}
+	return nil, fmt.Errorf("bad type: %s", name)
+}
+
+//go:embed templates/*.tmpl
+var templates embed.FS
+
+type generator struct {
+	cfg     Config
+	pkgs    []*packages.Package
+	dir     string
+	pkgname string
+	pkgpath string
+	build   constraint.Expr
+	module  string
+	imports map[string]string // Import names by path.
+	names   map[string]string // Package names by path.
+}
+
+// setpkg sets the package of the generated file.
+func (g *generator) setpkg(pkg *packages.Package) {
+	g.pkgname, g.pkgpath = pkg.Name, pkg.PkgPath
+	if pkg.Module != nil {
+		g.module = pkg.Module.Path
+	}
+}
+
+func (g *generator) wrap() ([]byte, error) {
+	path, ename, err := splitwrap(g.cfg.Wrap)
+	if err != nil {
+		return nil, err
+	}
+	name := cmp.Or(g.cfg.Name, ename)
+	var local, wrapped *packages.Package
+	for _, pkg := range g.pkgs {
+		if pkg.PkgPath == path && (wrapped == nil || pkg.ID == pkg.PkgPath) {
+			wrapped = pkg
+		}
+		if pkg.Dir != g.dir || strings.HasSuffix(pkg.PkgPath, ".test") ||
+			strings.HasSuffix(pkg.Name, "_test") {
+			continue
+		}
+		if local == nil || pkg.ID == pkg.PkgPath {
+			local = pkg
+		}
+	}
+	if local == nil {
+		return nil, fmt.Errorf("failed to load package in %s", g.cfg.Dir)
+	}
+	g.setpkg(local)
+	// Ignore a declaration left by a previous run of the same command.
+	if obj := local.Types.Scope().Lookup(name); obj != nil {
+		if !g.generated(local, obj) {
+			return nil, fmt.Errorf(
+				"bad name: %s is already declared in package %s",
+				name, g.pkgname)
+		}
+	}
+
+	if !importable(path, g.pkgpath) {
+		return nil, fmt.Errorf("bad wrap: %s cannot be imported from %s",
+			path, g.pkgpath)
+	}
+	if wrapped == nil || len(wrapped.Errors) > 0 {
+		return nil, fmt.Errorf("bad wrap: failed to load package %s", path)
+	}
+	obj, ok := wrapped.Types.Scope().Lookup(ename).(*types.TypeName)
+	if !ok || !obj.Exported() {
+		return nil, fmt.Errorf("bad wrap: %s has no exported type %s",
+			path, ename)
+	}
+	if g.build, err = buildconstraint(wrapped, obj); err != nil {
+		return nil, err
+	}
+	etype, ok := obj.Type().(*types.Named)
+	if !ok || etype.TypeParams().Len() > 0 {
+		return nil, fmt.Errorf("bad wrap: %s is not a non-generic named type",
+			g.cfg.Wrap)
+	}
+
+	var (
+		field = types.NewField(0, local.Types, ename, etype, true)
+		tname = types.NewTypeName(0, local.Types, name, nil)
+		st    = types.NewStruct([]*types.Var{field}, nil)
+		typ   = types.NewNamed(tname, st, nil)
+	)
+	return g.generate(local, typ, types.TypeString(etype, g.qualifier))
+}
+
+func splitwrap(target string) (path, name string, err error) {
+	i := strings.LastIndex(target, ".")
+	if i < 0 || strings.Contains(target[i:], "/") {
+		return "", "", fmt.Errorf("bad wrap: %s is not of the form path.TYPE",
+			target)
+	}
+	return target[:i], target[i+1:], nil
+}
+
+func (g *generator) generate(
+	pkg *packages.Package, typ types.Type, wrap string,
+) ([]byte, error) {
+	ntype, ok := typ.(*types.Named)
+	if !ok {
+		return nil, fmt.Errorf("could not get name of type '%s'", typ)
+	}
+	st, ok := ntype.Underlying().(*types.Struct)
+	if !ok {
+		return nil, fmt.Errorf("type is not a struct")
+	}
+	if ntype.TypeParams().Len() > 0 {
+		return nil, fmt.Errorf("bad type: %s is generic; embed an instance "+
+			"of it in a struct to mock it", ntype.Obj().Name())
+	}
+	data := Data{
+		Package: g.pkgname,
+		Type:    ntype.Obj().Name(),
+		Wrap:    wrap,
+		Fluent:  g.cfg.Fluent,
+	}
+	if field := statefield(st); field != nil {
+		if !isstate(field.Type()) {
+			return nil, fmt.Errorf("field 'moxie' must be of type state.State")
+		}
+		data.State = true
+	}
+
+	methods := map[string]bool{"": true}
+	declared := make(map[string]*types.Func)
+	for fn := range ntype.Methods() {
+		if g.generated(pkg, fn) {
+			continue
+		}
+		declared[fn.Name()] = fn
+		if !fn.Exported() {
+			continue
+		}
+		methods[fn.Name()] = true
+		if field := hides(pkg.Types, st, fn.Name()); field != nil {
+			g.warnf("%s.%s is not mocked: it is declared on %s, hiding "+
+				"the method promoted from embedded field %s",
+				data.Type, fn.Name(), data.Type, field.Name())
+		}
+	}
+	// Mocks have pointer receivers, so they can call methods with pointer
+	// receivers on embedded values, too.
+	for sel := range types.NewMethodSet(types.NewPointer(typ)).Methods() {
+		if !sel.Obj().Exported() || len(sel.Index()) == 1 {
+			continue // Declared on the type itself.
+		}
+		mname := sel.Obj().Name()
+		methods[mname] = true
+		if g.skip(mname) {
+			continue
+		}
+		tsig := sel.Type().(*types.Signature)
+		if !g.referable(data.Type, mname, tsig) {
+			continue
+		}
+		data.Methods = append(data.Methods,
+			g.typemethod(data.Type, mname, tsig, st.Field(sel.Index()[0])))
+	}
+	for _, name := range g.cfg.Proxy {
+		fn, ok := declared[name]
+		if !ok || fn.Exported() {
+			return nil, fmt.Errorf(
+				"bad proxy: %s has no unexported method %s", data.Type, name)
+		}
+		mname := capitalize(name)
+		if obj, _, _ := types.LookupFieldOrMethod(
+			typ, true, pkg.Types, mname,
+		); obj != nil && !g.generated(pkg, obj) {
+			return nil, fmt.Errorf("bad proxy: %s already has %s",
+				data.Type, mname)
+		}
+		methods[mname] = true
+		if g.skip(mname) {
+			continue
+		}
+		m := g.typemethod(data.Type, mname, fn.Signature(), nil)
+		m.Proxy = name
+		data.Methods = append(data.Methods, m)
+	}
+	amb := ambiguous(pkg.Types, typ, st)
+	for _, mname := range slices.Sorted(maps.Keys(amb)) {
+		fields := amb[mname]
+		fname, ok := g.cfg.Pick[mname]
+		if !ok {
+			g.warnf("%s.%s is ambiguous between embedded fields %s "+
+				"and is not mocked; pick one to mock it",
+				data.Type, mname, fieldnames(fields))
+			continue
+		}
+		i := slices.IndexFunc(fields, func(f *types.Var) bool {
+			return f.Name() == fname
+		})
+		if i < 0 {
+			return nil, fmt.Errorf(
+				"bad pick: %s.%s is promoted from %s, not %s",
+				data.Type, mname, fieldnames(fields), fname)
+		}
+		methods[mname] = true
+		if g.skip(mname) {
+			continue
+		}
+		obj, _, _ := types.LookupFieldOrMethod(
+			fields[i].Type(), true, pkg.Types, mname)
+		tsig := obj.Type().(*types.Signature)
+		if !g.referable(data.Type, mname, tsig) {
+			continue
+		}
+		data.Methods = append(data.Methods,
+			g.typemethod(data.Type, mname, tsig, fields[i]))
+	}
+	slices.SortFunc(data.Methods, func(a, b Method) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	for mname := range g.cfg.Pick {
+		if amb[mname] == nil {
+			return nil, fmt.Errorf("bad pick: %s.%s is not ambiguous",
+				data.Type, mname)
+		}
+	}
+	for mname := range g.cfg.Record {
+		if !methods[mname] {
+			return nil, fmt.Errorf("bad record: %s has no method %s",
+				data.Type, mname)
+		}
+	}
+	for _, mname := range slices.Concat(g.cfg.Methods, g.cfg.Exclude) {
+		if !methods[mname] {
+			return nil, fmt.Errorf("bad filter: %s has no method %s",
+				data.Type, mname)
+		}
+	}
+	if g.cfg.Fluent && methods["Mock"] {
+		return nil, fmt.Errorf("bad fluent: %s already has a method Mock",
+			data.Type)
+	}
+	return g.render("mock.tmpl", data)
+}
+
+// buildconstraint returns the build constraint of the file in pkg that declares
+// obj, so that the mock is built only where obj is, or nil if there is none.
+func buildconstraint(
+	pkg *packages.Package, obj types.Object,
+) (constraint.Expr, error) {
+	fname := pkg.Fset.Position(obj.Pos()).Filename
+	if fname == "" {
+		return nil, nil
+	}
+	return fileconstraint(fname)
+}
+
+// fileconstraint returns the build constraint of the file fname: its
+// //go:build line, or its // +build lines if it has none, and the GOOS and
+// GOARCH of its name.
+func fileconstraint(fname string) (constraint.Expr, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), fname, nil,
+		parser.PackageClauseOnly|parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse '%s': %w", fname, err)
+	}
+	var gobuild, plusbuild constraint.Expr
+	for _, group := range file.Comments {
+		if group.Pos() > file.Package {
+			break
+		}
+		for _, c := range group.List {
+			gobuildline := constraint.IsGoBuild(c.Text)
+			if !gobuildline && !constraint.IsPlusBuild(c.Text) {
+				continue
+			}
+			expr, err := constraint.Parse(c.Text)
+			if err != nil {
+				return nil, fmt.Errorf("bad build constraint in '%s': %w",
+					fname, err)
+			}
+			if gobuildline {
+				gobuild = cmp.Or(gobuild, expr)
+			} else {
+				plusbuild = andexpr(plusbuild, expr)
+			}
+		}
+	}
+	return andexpr(cmp.Or(gobuild, plusbuild), nameconstraint(fname)), nil
+}
+
+// nameconstraint returns the constraint implied by the _GOOS, _GOARCH, or
+// _GOOS_GOARCH suffix of the file name fname, or nil if there is none.
+func nameconstraint(fname string) constraint.Expr {
+	name := filepath.Base(fname)
+	if dot := strings.Index(name, "."); dot >= 0 {
+		name = name[:dot]
+	}
+	i := strings.Index(name, "_")
+	if i < 0 {
+		return nil
+	}
+	l := strings.Split(name[i:], "_")
+	if n := len(l); n > 0 && l[n-1] == "test" {
+		l = l[:n-1]
+	}
+	n := len(l)
+	if n >= 2 && knownos[l[n-2]] && knownarch[l[n-1]] {
+		return &constraint.AndExpr{
+			X: &constraint.TagExpr{Tag: l[n-2]},
+			Y: &constraint.TagExpr{Tag: l[n-1]},
+		}
+	}
+	if n >= 1 && (knownos[l[n-1]] || knownarch[l[n-1]]) {
+		return &constraint.TagExpr{Tag: l[n-1]}
+	}
+	return nil
+}
+
+// knownos and knownarch are the GOOS and GOARCH values that go/build matches
+// in file names.
+var (
+	knownos = map[string]bool{
+		"aix": true, "android": true, "darwin": true, "dragonfly": true,
+		"freebsd": true, "hurd": true, "illumos": true, "ios": true,
+		"js": true, "linux": true, "nacl": true, "netbsd": true,
+		"openbsd": true, "plan9": true, "solaris": true, "wasip1": true,
+		"windows": true, "zos": true,
+	}
+	knownarch = map[string]bool{
+		"386": true, "amd64": true, "amd64p32": true, "arm": true,
+		"armbe": true, "arm64": true, "arm64be": true, "loong64": true,
+		"mips": true, "mipsle": true, "mips64": true, "mips64le": true,
+		"mips64p32": true, "mips64p32le": true, "ppc": true, "ppc64": true,
+		"ppc64le": true, "riscv": true, "riscv64": true, "s390": true,
+		"s390x": true, "sparc": true, "sparc64": true, "wasm": true,
+	}
+)
+
+// andexpr returns x && y, or whichever is not nil.
+func andexpr(x, y constraint.Expr) constraint.Expr {
+	switch {
+	case x == nil:
+		return y
+	case y == nil:
+		return x
+	}
+	return &constraint.AndExpr{X: x, Y: y}
+}
+
+// hides returns the embedded field of st whose method name is hidden by a
+// method of the same name declared on the struct type, or nil if there is none.
+func hides(pkg *types.Package, st *types.Struct, name string) *types.Var {
+	for field := range st.Fields() {
+		if !field.Embedded() {
+			continue
+		}
+		obj, _, _ := types.LookupFieldOrMethod(field.Type(), true, pkg, name)
+		if _, ok := obj.(*types.Func); ok {
+			return field
+		}
+	}
+	return nil
+}
+
+// generated reports whether obj is declared in the file the mock is written
+// to, by a previous run.
+func (g *generator) generated(pkg *packages.Package, obj types.Object) bool {
+	file := pkg.Fset.Position(obj.Pos()).Filename
+	return filepath.Base(file) == g.cfg.Filename()
+}
+
+// typemethod describes the method name of type tname, promoted from field
+// if it is not nil.
+func (g *generator) typemethod(
+	tname, name string, sig *types.Signature, field *types.Var,
+) Method {
+	m := g.method(tname, name, name, sig)
+	m.Context = ctxparam(sig) != nil
+	m.Limit = g.limit(name)
+	m.Record = m.Limit >= 0
+	if field != nil {
+		m.Embed = field.Name()
+		m.EmbedType = types.TypeString(field.Type(), g.qualifier)
+		m.Nilable = nilable(field.Type())
+	}
+	return m
+}
+
+// referable reports whether the generated file can refer to every type in
+// sig, the signature of the method tname.name, and warns if it cannot.
+func (g *generator) referable(tname, name string, sig *types.Signature) bool {
+	t, reason := g.unreferable(sig)
+	if t == nil {
+		return true
+	}
+	g.warnf("%s.%s is not mocked: its signature refers to %s, %s",
+		tname, name, types.TypeString(t, nil), reason)
+	return false
+}
+
+// unreferable returns a type within t that code in the generated package
+// cannot spell, and the reason why, or nil if there is none.
+func (g *generator) unreferable(t types.Type) (types.Type, string) {
+	local := func(pkg *types.Package) bool {
+		return pkg == nil || pkg.Path() == g.pkgpath
+	}
+	switch t := t.(type) {
+	case *types.Named, *types.Alias:
+		n := t.(interface {
+			Obj() *types.TypeName
+			TypeArgs() *types.TypeList
+		})
+		obj := n.Obj()
+		switch {
+		case local(obj.Pkg()):
+		case !obj.Exported():
+			return t, "which is unexported"
+		case !importable(obj.Pkg().Path(), g.pkgpath):
+			return t, "which is in an internal package"
+		}
+		for arg := range n.TypeArgs().Types() {
+			if u, reason := g.unreferable(arg); u != nil {
+				return u, reason
+			}
+		}
+	case *types.Pointer:
+		return g.unreferable(t.Elem())
+	case *types.Slice:
+		return g.unreferable(t.Elem())
+	case *types.Array:
+		return g.unreferable(t.Elem())
+	case *types.Chan:
+		return g.unreferable(t.Elem())
+	case *types.Map:
+		if u, reason := g.unreferable(t.Key()); u != nil {
+			return u, reason
+		}
+		return g.unreferable(t.Elem())
+	case *types.Tuple:
+		for v := range t.Variables() {
+			if u, reason := g.unreferable(v.Type()); u != nil {
+				return u, reason
+			}
+		}
+	case *types.Signature:
+		if u, reason := g.unreferable(t.Params()); u != nil {
+			return u, reason
+		}
+		return g.unreferable(t.Results())
+	case *types.Struct:
+		for f := range t.Fields() {
+			if !f.Exported() && !local(f.Pkg()) {
+				return t, "which has unexported fields"
+			}
+			if u, reason := g.unreferable(f.Type()); u != nil {
+				return u, reason
+			}
+		}
+	case *types.Interface:
+		for m := range t.ExplicitMethods() {
+			if !m.Exported() && !local(m.Pkg()) {
+				return t, "which has unexported methods"
+			}
+			if u, reason := g.unreferable(m.Type()); u != nil {
+				return u, reason
+			}
+		}
+		for e := range t.EmbeddedTypes() {
+			if u, reason := g.unreferable(e); u != nil {
+				return u, reason
+			}
+		}
+	}
+	return nil, ""
+}
+
+// importable reports whether the package path can be imported from the
+// package from, following the rules for internal packages.
+func importable(path, from string) bool {
+	elems := strings.Split(path, "/")
+	i := len(elems) - 1
+	for i >= 0 && elems[i] != "internal" {
+		i--
+	}
+	if i < 0 {
+		return true
+	}
+	parent := strings.Join(elems[:i], "/")
+	return from == parent || strings.HasPrefix(from, parent+"/")
+}
+
+// skip reports whether the method name is left out by Config.Methods or
+// Config.Exclude.
+func (g *generator) skip(name string) bool {
+	return len(g.cfg.Methods) > 0 && !slices.Contains(g.cfg.Methods, name) ||
+		slices.Contains(g.cfg.Exclude, name)
+}
+
+func (g *generator) warnf(format string, args ...any) {
+	if g.cfg.Warnf != nil {
+		g.cfg.Warnf(format, args...)
+	}
+}
+
+// ambiguous returns the exported methods that typ would promote from more
+// than one embedded field of its underlying struct st at the same depth,
+// mapped to those fields. Go leaves such methods out of the method set.
+func ambiguous(
+	pkg *types.Package, typ types.Type, st *types.Struct,
+) map[string][]*types.Var {
+	amb := make(map[string][]*types.Var)
+	for field := range st.Fields() {
+		if !field.Embedded() {
+			continue
+		}
+		mset := types.NewMethodSet(addressable(field.Type()))
+		for sel := range mset.Methods() {
+			mname := sel.Obj().Name()
+			if !sel.Obj().Exported() || amb[mname] != nil {
+				continue
+			}
+			obj, idx, _ := types.LookupFieldOrMethod(typ, true, pkg, mname)
+			if obj != nil || idx == nil {
+				continue
+			}
+			var depth int
+			for f := range st.Fields() {
+				if !f.Embedded() {
+					continue
+				}
+				_, fidx, _ := types.LookupFieldOrMethod(
+					f.Type(), true, pkg, mname)
+				switch {
+				case fidx == nil:
+				case depth == 0 || len(fidx) < depth:
+					depth = len(fidx)
+					amb[mname] = []*types.Var{f}
+				case len(fidx) == depth:
+					amb[mname] = append(amb[mname], f)
+				}
+			}
+		}
+	}
+	return amb
+}
+
+// addressable returns the type whose method set holds the methods callable
+// on an addressable value of type t.
+func addressable(t types.Type) types.Type {
+	if _, ok := t.(*types.Pointer); ok || types.IsInterface(t) {
+		return t
+	}
+	return types.NewPointer(t)
+}
+
+func fieldnames(fields []*types.Var) string {
+	names := make([]string, len(fields))
+	for i, f := range fields {
+		names[i] = f.Name()
+	}
+	return strings.Join(names, " and ")
+}
+
+func (g *generator) generatefunc(obj types.Object) ([]byte, error) {
+	v, ok := obj.(*types.Var)
+	if !ok || v.Parent() != v.Pkg().Scope() {
+		return nil, fmt.Errorf("bad func: %s is not a package-level variable",
+			obj.Name())
+	}
+	tsig, ok := v.Type().Underlying().(*types.Signature)
+	if !ok {
+		return nil, fmt.Errorf("bad func: %s is not a function variable",
+			v.Name())
+	}
+	return g.render("func.tmpl", Data{
+		Package: g.pkgname,
+		Type:    v.Name(),
+		VarType: types.TypeString(v.Type(), g.qualifier),
+		Methods: []Method{g.method(v.Name(), v.Name(), "", tsig)},
+	})
+}
+
+func (g *generator) generatefunctype(obj types.Object) ([]byte, error) {
+	tn, ok := obj.(*types.TypeName)
+	if !ok {
+		return nil, fmt.Errorf("bad functype: %s is not a type", obj.Name())
+	}
+	named, ok := tn.Type().(*types.Named)
+	if !ok || named.TypeParams().Len() > 0 {
+		return nil, fmt.Errorf(
+			"bad functype: %s is not a non-generic named type", tn.Name())
+	}
+	tsig, ok := named.Underlying().(*types.Signature)
+	if !ok {
+		return nil, fmt.Errorf("bad functype: %s is not a function type",
+			tn.Name())
+	}
+	delete(g.imports, "testing")
+	return g.render("functype.tmpl", Data{
+		Package: g.pkgname,
+		Type:    tn.Name(),
+		Methods: []Method{g.method(tn.Name(), tn.Name(), "", tsig)},
+	})
+}
+
+// method describes the function or method name of type tname with signature
+// sig. The signature is rendered with the name sname.
+func (g *generator) method(
+	tname, name, sname string, sig *types.Signature,
+) Method {
+	// Import every package in the signature before naming parameters,
+	// so that no parameter shadows an import.
+	for _, tup := range []*types.Tuple{sig.Params(), sig.Results()} {
+		for v := range tup.Variables() {
+			types.TypeString(v.Type(), g.qualifier)
+		}
+	}
+	m := Method{
+		Type:         tname,
+		Name:         name,
+		Variadic:     sig.Variadic(),
+		Signature:    g.signature(sname, sig),
+		Args:         g.args(sig.Params(), sig.Variadic()),
+		CallArgs:     g.args(sig.Params(), false),
+		ParamTypes:   g.argtypes(sig.Params(), sig.Variadic()),
+		ResultParams: g.resultparams(sig.Results()),
+		ResultTypes:  g.resulttypes(sig.Results()),
+		ResultArgs:   g.resultargs(sig.Results()),
+	}
+	var (
+		names  = g.paramnames(sig.Params(), false)
+		fields = g.paramnames(sig.Params(), true)
+	)
+	for i := range sig.Params().Len() {
+		m.Params = append(m.Params, Var{
+			Name:  names[i],
+			Field: fields[i],
+			Type:  types.TypeString(sig.Params().At(i).Type(), g.qualifier),
+		})
+	}
+	names = g.resultnames(sig.Results())
+	for i := range sig.Results().Len() {
+		m.Results = append(m.Results, Var{
+			Name:  names[i],
+			Field: capitalize(names[i]),
+			Type:  types.TypeString(sig.Results().At(i).Type(), g.qualifier),
+		})
+	}
+	return m
+}
+
+func (g *generator) render(name string, data Data) ([]byte, error) {
+	tmpl, err := template.New("").
+		Funcs(template.FuncMap{"qualifier": g.qualifierpath}).
+		ParseFS(templates, "templates/*.tmpl")
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse templates: %w", err)
+	}
+	if g.cfg.Template != "" {
+		tmpl, err = tmpl.ParseFS(os.DirFS(g.cfg.Template), "*.tmpl")
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse templates in '%s': %w",
+				g.cfg.Template, err)
+		}
+	}
+	var buf strings.Builder
+	if err := tmpl.ExecuteTemplate(&buf, name, data); err != nil {
+		return nil, fmt.Errorf("failed to execute template: %w", err)
+	}
+	src, err := g.format(buf.String())
+	if err != nil || g.cfg.NoCheck {
+		return src, err
+	}
+	fname := filepath.Join(g.dir, g.cfg.Filename())
+	err = Check(g.pkgs, g.cfg.Tags, map[string][]byte{fname: src})
+	if err != nil {
+		return nil, err
+	}
+	return src, nil
+}
+
+func (g *generator) format(src string) ([]byte, error) {
+	src = strings.Replace(src, "import()", g.importblock(), 1)
+	build := g.build
+	if g.cfg.Export {
+		build = andexpr(build, &constraint.TagExpr{Tag: "moxie"})
+	}
+	if build != nil {
+		src = strings.Replace(src, "\n\npackage ",
+			"\n\n//go:build "+build.String()+"\n\npackage ", 1)
+	}
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse generated source: %w", err)
+	}
+	if g.cfg.Export {
+		export(file)
+	}
+	// Types qualified only for messages, such as Method.EmbedType in a
+	// panic, import packages that the code does not use.
+	for _, imp := range slices.Clone(file.Imports) {
+		path, _ := strconv.Unquote(imp.Path.Value)
+		if !astutil.UsesImport(file, path) {
+			var name string
+			if imp.Name != nil {
+				name = imp.Name.Name
+			}
+			astutil.DeleteNamedImport(fset, file, name, path)
+		}
+	}
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, file); err != nil {
+		return nil, fmt.Errorf("failed to format generated source: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (g *generator) limit(mname string) int {
+	if limit, ok := g.cfg.Record[mname]; ok {
+		return limit
+	}
+	if limit, ok := g.cfg.Record[""]; ok {
+		return limit
+	}
+	return -1
+}
+
+// export renames the helpers in a generated file so that they can be used
+// from other packages, such as _Read_Do to Read_Do and _now_Do to Now_Do.
+// Internal declarations, such as the mock data of a type, keep their names.
+func export(file *ast.File) {
+	names := make(map[string]string)
+	add := func(name string) {
+		if strings.HasPrefix(name, "_") {
+			names[name] = capitalize(name[1:])
+		}
+	}
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if decl.Recv == nil {
+				if slices.ContainsFunc(
+					[]string{"_Do", "_DoAll", "_Stub", "_Return", "_Calls",
+						"_New"},
+					func(s string) bool {
+						return strings.HasSuffix(decl.Name.Name, s)
+					},
+				) {
+					add(decl.Name.Name)
+				}
+				continue
+			}
+			recv := decl.Recv.List[0].Type
+			if star, ok := recv.(*ast.StarExpr); ok {
+				recv = star.X
+			}
+			if id, ok := recv.(*ast.Ident); ok &&
+				(!strings.HasPrefix(id.Name, "_") ||
+					strings.HasSuffix(id.Name, "Mock")) {
+				add(decl.Name.Name)
+			}
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				ts, ok := spec.(*ast.TypeSpec)
+				if ok && (strings.HasSuffix(ts.Name.Name, "_Call") ||
+					strings.HasSuffix(ts.Name.Name, "Mock")) {
+					add(ts.Name.Name)
+				}
+			}
+		}
+	}
+	ast.Inspect(file, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok {
+			if name, ok := names[id.Name]; ok {
+				id.Name = name
+			}
+		}
+		return true
+	})
+}
+
+func statefield(st *types.Struct) *types.Var {
+	for field := range st.Fields() {
+		if field.Name() == "moxie" && !field.Embedded() {
+			return field
+		}
+	}
+	return nil
+}
+
+// isstate reports whether typ is lesiw.io/moxie/mock/state.State.
+func isstate(typ types.Type) bool {
+	named, ok := typ.(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Obj()
+	return obj.Pkg() != nil &&
+		obj.Pkg().Path() == "lesiw.io/moxie/mock/state" &&
+		obj.Name() == "State"
+}
+
+// nilable reports whether typ is an interface, whose methods cannot be called
+// while it is nil. Pointers are left to their methods, which may accept nil.
+func nilable(typ types.Type) bool {
+	_, ok := typ.Underlying().(*types.Interface)
+	return ok
+}
+
+func ctxparam(sig *types.Signature) *types.Package {
+	if sig.Params().Len() == 0 {
+		return nil
+	}
+	named, ok := types.Unalias(sig.Params().At(0).Type()).(*types.Named)
+	if !ok {
+		return nil
+	}
+	obj := named.Obj()
+	if obj.Pkg() == nil || obj.Pkg().Path() != "context" ||
+		obj.Name() != "Context" {
+		return nil
+	}
+	return obj.Pkg()
+}
+
+func snakecase(s string) string {
+	var (
+		result strings.Builder
+		runes  = []rune(s)
+	)
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			// Split before an upper case letter that starts a word, keeping
+			// acronyms such as "RW" in "FakeRW" together.
+			if i > 0 && (!unicode.IsUpper(runes[i-1]) ||
+				i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+				result.WriteByte('_')
+			}
+			result.WriteRune(unicode.ToLower(r))
+		} else {
+			result.WriteRune(r)
+		}
+	}
+	return result.String()
+}
+
+func (g *generator) signature(name string, sig *types.Signature) string {
+	var b strings.Builder
+	b.WriteString(name)
+	b.WriteString("(")
+	names := g.paramnames(sig.Params(), false)
+	for i := range sig.Params().Len() {
+		p := sig.Params().At(i)
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(names[i])
+		b.WriteString(" ")
+		variadic := i == sig.Params().Len()-1 && sig.Variadic()
+		b.WriteString(g.paramtype(p, variadic))
+	}
+	b.WriteString(")")
+	if sig.Results().Len() > 0 {
+		b.WriteString(" ")
+		if sig.Results().Len() > 1 {
+			b.WriteString("(")
+		}
+		for i := range sig.Results().Len() {
+			r := sig.Results().At(i)
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			b.WriteString(types.TypeString(r.Type(), g.qualifier))
+		}
+		if sig.Results().Len() > 1 {
+			b.WriteString(")")
+		}
+	}
+	return b.String()
+}
+
+func (g *generator) args(tup *types.Tuple, variadic bool) string {
+	var (
+		b     strings.Builder
+		names = g.paramnames(tup, false)
+	)
+	for i := range tup.Len() {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(names[i])
+		if i == tup.Len()-1 && variadic {
+			b.WriteString("...")
+		}
+	}
+	return b.String()
+}
+
+func (g *generator) argtypes(tup *types.Tuple, variadic bool) string {
+	var b strings.Builder
+	for i := range tup.Len() {
+		v := tup.At(i)
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(g.paramtype(v, i == tup.Len()-1 && variadic))
+	}
+	return b.String()
+}
+
+// paramtype returns the type of the parameter v as written in a signature.
+// The type of a variadic parameter is its element type, after "...".
+func (g *generator) paramtype(v *types.Var, variadic bool) string {
+	if s, ok := v.Type().(*types.Slice); ok && variadic {
+		return "..." + types.TypeString(s.Elem(), g.qualifier)
+	}
+	return types.TypeString(v.Type(), g.qualifier)
+}
+
+// imported reports whether name is the name of an import.
+func (g *generator) imported(name string) bool {
+	for _, v := range g.imports {
+		if v == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *generator) resultparams(tup *types.Tuple) string {
+	var (
+		b     strings.Builder
+		names = g.resultnames(tup)
+	)
+	for i := range tup.Len() {
+		v := tup.At(i)
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(names[i])
+		b.WriteString(" " + types.TypeString(v.Type(), g.qualifier))
+	}
+	return b.String()
+}
+
+func (g *generator) resulttypes(tup *types.Tuple) string {
+	var b strings.Builder
+	for i := range tup.Len() {
+		v := tup.At(i)
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(types.TypeString(v.Type(), g.qualifier))
+	}
+	return b.String()
+}
+
+func (g *generator) resultargs(tup *types.Tuple) string {
+	var (
+		b     strings.Builder
+		names = g.resultnames(tup)
+	)
+	for i := range tup.Len() {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(names[i])
+	}
+	return b.String()
+}
+
+// qualifierpath is like qualifier, but takes the import path of a package.
+// Packages that are not loaded are assumed to be named after the last
+// element of their path.
+func (g *generator) qualifierpath(path string) string {
+	if pkg := g.findpkg(path); pkg != nil {
+		return g.qualifier(pkg)
+	}
+	name := pathpkg.Base(path)
+	if _, ok := g.names[path]; !ok {
+		g.names[path] = name
+	}
+	if major := strings.TrimPrefix(name, "v"); major != name &&
+		strings.Trim(major, "0123456789") == "" && pathpkg.Dir(path) != "." {
+		name = pathpkg.Base(pathpkg.Dir(path))
+	}
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' {
+			return r
+		}
+		return '_'
+	}, name)
+	return g.qualifier(types.NewPackage(path, name))
+}
+
+// findpkg returns the loaded package with the import path, or nil.
+func (g *generator) findpkg(path string) *types.Package {
+	seen := make(map[*types.Package]bool)
+	var find func(pkgs []*types.Package) *types.Package
+	find = func(pkgs []*types.Package) *types.Package {
+		for _, pkg := range pkgs {
+			if seen[pkg] {
+				continue
+			}
+			seen[pkg] = true
+			if pkg.Path() == path {
+				return pkg
+			}
+			if found := find(pkg.Imports()); found != nil {
+				return found
+			}
+		}
+		return nil
+	}
+	for _, pkg := range g.pkgs {
+		if pkg.Types != nil {
+			if found := find([]*types.Package{pkg.Types}); found != nil {
+				return found
+			}
+		}
+	}
+	return nil
+}
+
+func (g *generator) qualifier(pkg *types.Package) string {
+	if name, ok := g.imports[pkg.Path()]; ok {
+		return name
+	}
+	if _, ok := g.names[pkg.Path()]; !ok {
+		g.names[pkg.Path()] = pkg.Name()
+	}
+	name := pkg.Name()
+	if pkg.Path() == g.pkgpath {
+		name = ""
+	}
+pickname:
+	for _, v := range g.imports {
+		if v == name {
+			name = name + "_"
+			goto pickname
+		}
+	}
+	g.imports[pkg.Path()] = name
+	return g.imports[pkg.Path()]
+}
+
+func (g *generator) importblock() string {
+	var std, ext, local []string
+	for _, path := range keys(g.imports) {
+		name := g.imports[path]
+		if name == "" {
+			continue
+		}
+		var line string
+		if name == g.names[path] {
+			line = fmt.Sprintf("\t%q\n", path)
+		} else {
+			line = fmt.Sprintf("\t%s %q\n", name, path)
+		}
+		switch {
+		case g.local(path):
+			local = append(local, line)
+		case strings.Contains(strings.SplitN(path, "/", 2)[0], "."):
+			ext = append(ext, line)
+		default:
+			std = append(std, line)
+		}
+	}
+	var b strings.Builder
+	b.WriteString("import (\n")
+	for i, group := range [][]string{std, ext, local} {
+		if len(group) == 0 {
+			continue
+		}
+		if i > 0 && b.Len() > len("import (\n") {
+			b.WriteString("\n")
+		}
+		for _, line := range group {
+			b.WriteString(line)
+		}
+	}
+	b.WriteString(")")
+
+	return b.String()
+}
+
+// local reports whether path belongs in the last import group: a package
+// matching Config.Local, or else in the module of the generated file.
+func (g *generator) local(path string) bool {
+	if len(g.cfg.Local) > 0 {
+		return slices.ContainsFunc(g.cfg.Local, func(prefix string) bool {
+			return strings.HasPrefix(path, prefix) ||
+				strings.TrimSuffix(prefix, "/") == path
+		})
+	}
+	return g.module != "" &&
+		(path == g.module || strings.HasPrefix(path, g.module+"/"))
+}
+
+// paramnames returns names for the parameters in tup, avoiding the names of
+// imports, which the generated code refers to.
+func (g *generator) paramnames(tup *types.Tuple, export bool) []string {
+	names := make([]string, 0, tup.Len())
+	for i := range tup.Len() {
+		var (
+			v    = tup.At(i)
+			name string
+		)
+		if v.Name() == "" {
+			name = fmt.Sprintf("P%d", i)
+		} else {
+			name = v.Name()
+			if export {
+				name = capitalize(name)
+			}
+		}
+		for slices.Contains(names, name) || g.imported(name) {
+			name = name + "_"
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// resultnames returns names for the results in tup, avoiding the names of
+// imports and of the other parameters of generated helpers.
+func (g *generator) resultnames(tup *types.Tuple) []string {
+	names := make([]string, 0, tup.Len())
+	for i := range tup.Len() {
+		var (
+			v    = tup.At(i)
+			name string
+		)
+		if v.Name() == "" {
+			name = fmt.Sprintf("r%d", i)
+		} else if v.Name() == "error" {
+			// Fix a common type shadowing error.
+			name = "err"
+		} else {
+			name = v.Name()
+		}
+		for slices.Contains(names, name) || g.imported(name) ||
+			name == "t" || name == "ctx" {
+			name = name + "_"
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+func keys[M ~map[K]V, K cmp.Ordered, V any](m M) []K {
+	r := make([]K, 0, len(m))
+	for k := range m {
+		r = append(r, k)
+	}
+	slices.Sort(r)
+	return r
+}
+
+func capitalize(s string) string {
+	runes := []rune(s)
+	return string(append([]rune{unicode.ToUpper(runes[0])}, runes[1:]...))
+}
@@ -0,0 +1,490 @@
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// testpkgs loads the packages of internal/testdata once, for the tests that
+// do not depend on how they are loaded.
+var testpkgs = sync.OnceValues(func() ([]*packages.Package, error) {
+	return Load("../internal/testdata", nil, "./...", "io")
+})
+
+// testconfig returns a Config for a mock in internal/testdata.
+func testconfig(t *testing.T) Config {
+	t.Helper()
+	pkgs, err := testpkgs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return Config{Dir: "../internal/testdata", Packages: pkgs}
+}
+
+// testwarnconfig returns a Config for a mock in internal/testdata that
+// collects its warnings.
+func testwarnconfig(t *testing.T) (Config, *[]string) {
+	t.Helper()
+	var warnings []string
+	cfg := testconfig(t)
+	cfg.Warnf = func(format string, args ...any) {
+		warnings = append(warnings, fmt.Sprintf(format, args...))
+	}
+	return cfg, &warnings
+}
+
+func TestGenerateRepeatable(t *testing.T) {
+	cfg := testconfig(t)
+	cfg.Type, cfg.Fluent = "M0", true
+	first, err := Generate(cfg)
+	if err != nil {
+		t.Fatalf("Generate(%+v): %v", cfg, err)
+	}
+	second, err := Generate(cfg)
+	if err != nil {
+		t.Fatalf("Generate(%+v): %v", cfg, err)
+	}
+	if !bytes.Equal(first, second) {
+		t.Error("Generate(): output differs between runs")
+	}
+}
+
+func TestGenerateWrap(t *testing.T) {
+	cfg := testconfig(t)
+	cfg.Wrap, cfg.Name = "io.Reader", "FakeIOReader"
+	src, err := Generate(cfg)
+	if err != nil {
+		t.Fatalf("Generate(%+v): %v", cfg, err)
+	}
+	want := []byte("type FakeIOReader struct {\n\tio.Reader\n}")
+	if !bytes.Contains(src, want) {
+		t.Errorf("Generate(%+v): want output containing %q, got:\n%s",
+			cfg, want, src)
+	}
+}
+
+func TestGenerateAmbiguous(t *testing.T) {
+	cfg, w := testwarnconfig(t)
+	cfg.Type = "M3"
+	src, err := Generate(cfg)
+	if err != nil {
+		t.Fatalf("Generate(%+v): %v", cfg, err)
+	}
+	warnings := *w
+	if bytes.Contains(src, []byte(") Close() error")) {
+		t.Errorf("Generate(%+v): want no Close method, got:\n%s", cfg, src)
+	}
+	want := "M3.Close is ambiguous between embedded fields " +
+		"ReadCloser and WriteCloser"
+	if len(warnings) != 1 || !strings.Contains(warnings[0], want) {
+		t.Errorf("Generate(%+v): want warning containing %q, got %q",
+			cfg, want, warnings)
+	}
+}
+
+func TestGenerateDeclared(t *testing.T) {
+	cfg, w := testwarnconfig(t)
+	cfg.Type = "M4"
+	src, err := Generate(cfg)
+	if err != nil {
+		t.Fatalf("Generate(%+v): %v", cfg, err)
+	}
+	warnings := *w
+	for _, method := range []string{
+		") Name() string", ") Close() error", ") Validate(",
+	} {
+		if bytes.Contains(src, []byte(method)) {
+			t.Errorf("Generate(%+v): want no %q method, got:\n%s",
+				cfg, method, src)
+		}
+	}
+	want := "M4.Close is not mocked: it is declared on M4, hiding the " +
+		"method promoted from embedded field ReadCloser"
+	if len(warnings) != 1 || !strings.Contains(warnings[0], want) {
+		t.Errorf("Generate(%+v): want warning containing %q, got %q",
+			cfg, want, warnings)
+	}
+}
+
+func TestGenerateMethodNamedType(t *testing.T) {
+	cfg := testconfig(t)
+	cfg.Type = "Value"
+	src, err := Generate(cfg)
+	if err != nil {
+		t.Fatalf("Generate(%+v): %v", cfg, err)
+	}
+	for _, helper := range []string{
+		") _Value_Record(t testing.TB", ") _Value_RecordAll(t testing.TB",
+	} {
+		if !bytes.Contains(src, []byte(helper)) {
+			t.Errorf("Generate(%+v): want %q helper, got:\n%s",
+				cfg, helper, src)
+		}
+	}
+}
+
+func TestGenerateTemplate(t *testing.T) {
+	for _, tt := range []struct {
+		cfg  Config
+		want string
+	}{
+		{Config{Type: "M1"}, ") _Value_AssertCalls(t testing.TB"},
+		{Config{Func: "now"}, "func _now_AssertCalls(t testing.TB"},
+	} {
+		cfg := testconfig(t)
+		cfg.Type, cfg.Func = tt.cfg.Type, tt.cfg.Func
+		cfg.Template = "../internal/testdata/templates"
+		src, err := Generate(cfg)
+		if err != nil {
+			t.Fatalf("Generate(%+v): %v", cfg, err)
+		}
+		if !bytes.Contains(src, []byte(tt.want)) {
+			t.Errorf("Generate(%+v): want output containing %q, got:\n%s",
+				cfg, tt.want, src)
+		}
+	}
+}
+
+func TestGenerateUnreferable(t *testing.T) {
+	cfg, w := testwarnconfig(t)
+	cfg.Wrap = "lesiw.io/moxie/internal/testdata/pkg.T2"
+	cfg.Name = "FakeUnreferable"
+	src, err := Generate(cfg)
+	if err != nil {
+		t.Fatalf("Generate(%+v): %v", cfg, err)
+	}
+	warnings := *w
+	if !bytes.Contains(src, []byte(") Visible() pkg.Int")) {
+		t.Errorf("Generate(%+v): want Visible method, got:\n%s", cfg, src)
+	}
+	for i, want := range []string{
+		"FakeUnreferable.Hidden is not mocked: its signature refers to " +
+			"lesiw.io/moxie/internal/testdata/pkg/internal/hidden.T, " +
+			"which is in an internal package",
+		"FakeUnreferable.Internal is not mocked",
+		"FakeUnreferable.Secret is not mocked: its signature refers to " +
+			"lesiw.io/moxie/internal/testdata/pkg.secret, which is unexported",
+	} {
+		if i >= len(warnings) || !strings.Contains(warnings[i], want) {
+			t.Errorf("Generate(%+v): want warning %d containing %q, got %q",
+				cfg, i, want, warnings)
+		}
+	}
+}
+
+func TestGenerateTags(t *testing.T) {
+	cfg := testconfig(t)
+	cfg.Type = "M5"
+	if _, err := Generate(cfg); err == nil {
+		t.Errorf("Generate(%+v) without tags: want error, got <nil>", cfg)
+	}
+	t.Setenv("GOOS", "linux") // For M7, declared in m7_linux.go.
+	pkgs, err := Load("../internal/testdata", []string{"tagged"}, ".")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		cfg  Config
+		want string
+	}{{
+		cfg:  Config{Type: "M5"},
+		want: "//go:build tagged\n",
+	}, {
+		// M5 already has a mock in its tests, so this one cannot compile.
+		cfg:  Config{Type: "M5", Export: true, NoCheck: true},
+		want: "//go:build tagged && moxie\n",
+	}, {
+		cfg:  Config{Type: "M7"},
+		want: "//go:build linux\n",
+	}} {
+		cfg := tt.cfg
+		cfg.Dir, cfg.Packages = "../internal/testdata", pkgs
+		src, err := Generate(cfg)
+		if err != nil {
+			t.Errorf("Generate(%+v) with tags: %v", cfg, err)
+			continue
+		}
+		if !bytes.Contains(src, []byte(tt.want)) {
+			t.Errorf("Generate(%+v) with tags: want %q, got:\n%s",
+				cfg, tt.want, src)
+		}
+	}
+}
+
+func TestBuildFlags(t *testing.T) {
+	for _, tt := range []struct {
+		goflags string
+		tags    []string
+		want    []string
+	}{
+		{"", nil, nil},
+		{"", []string{"tagged"}, []string{"-tags=tagged"}},
+		{"-tags=other,tagged", nil, []string{"-tags=other,tagged"}},
+		{"-mod=mod -tags=other", []string{"tagged", "other"},
+			[]string{"-tags=other,tagged"}},
+	} {
+		t.Setenv("GOFLAGS", tt.goflags)
+		got, err := BuildFlags(tt.tags...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("BuildFlags(%q) with GOFLAGS=%q: want %q, got %q",
+				tt.tags, tt.goflags, tt.want, got)
+		}
+	}
+}
+
+func TestFileConstraint(t *testing.T) {
+	dir := t.TempDir()
+	for _, tt := range []struct {
+		name, src, want string
+	}{
+		{"t.go", "package p\n", ""},
+		{"linux.go", "package p\n", ""},
+		{"t_linux.go", "package p\n", "linux"},
+		{"t_amd64_test.go", "package p\n", "amd64"},
+		{"t_linux_amd64.go", "package p\n", "linux && amd64"},
+		{"t_other.go", "//go:build a || b\n\npackage p\n", "a || b"},
+		{"t_windows.go", "//go:build a\n\npackage p\n", "a && windows"},
+		{"t.go", "// +build a b\n// +build c\n\npackage p\n",
+			"(a || b) && c"},
+		{"t.go", "//go:build a\n// +build b\n\npackage p\n", "a"},
+	} {
+		fname := filepath.Join(dir, tt.name)
+		if err := os.WriteFile(fname, []byte(tt.src), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		expr, err := fileconstraint(fname)
+		if err != nil {
+			t.Errorf("fileconstraint(%s): %v", tt.name, err)
+			continue
+		}
+		var got string
+		if expr != nil {
+			got = expr.String()
+		}
+		if got != tt.want {
+			t.Errorf("fileconstraint(%s) with %q: want %q, got %q",
+				tt.name, tt.src, tt.want, got)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	pkgs, err := testpkgs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		file string
+		pkgs []*packages.Package
+	}{
+		{"../internal/testdata/mock_bad_test.go", pkgs},
+		{"../internal/testdata/pkg/cache/mock_bad.go", nil}, // Loaded.
+	} {
+		fname, err := filepath.Abs(tt.file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		src := []byte("package " + filepath.Base(filepath.Dir(fname)) +
+			"\n\nvar _ int = \"\"\n")
+		err = Check(tt.pkgs, nil, map[string][]byte{fname: src})
+		if err == nil || !strings.Contains(err.Error(), fname+":3:") {
+			t.Errorf("Check(%s): want error at line 3, got %v", fname, err)
+		}
+	}
+}
+
+func TestImportable(t *testing.T) {
+	for _, tt := range []struct {
+		path, from string
+		want       bool
+	}{
+		{"io", "example.com/a", true},
+		{"internal/poll", "example.com/a", false},
+		{"example.com/a/internal/b", "example.com/a", true},
+		{"example.com/a/internal/b", "example.com/a/c", true},
+		{"example.com/a/internal/b", "example.com/ab", false},
+		{"example.com/a/internal", "example.com/a/internal/c", true},
+		{"example.com/a/internal/b/internal/c", "example.com/a/d", false},
+	} {
+		if got := importable(tt.path, tt.from); got != tt.want {
+			t.Errorf("importable(%q, %q): want %v, got %v",
+				tt.path, tt.from, tt.want, got)
+		}
+	}
+}
+
+func TestGenerateGeneric(t *testing.T) {
+	cfg := testconfig(t)
+	cfg.Type = "M6"
+	src, err := Generate(cfg)
+	if err != nil {
+		t.Fatalf("Generate(%+v): %v", cfg, err)
+	}
+	for _, want := range []string{
+		// Type arguments from another package.
+		"Get(key pkg.String) (*pkg.User, bool) {",
+		"Put(key pkg.String, value *pkg.User) {",
+		// Function parameters.
+		"Each(fn func(key pkg.String, value *pkg.User) bool) {",
+		"Keys(filters ...func(pkg.String) bool) []pkg.String {",
+		// A parameter named like an import.
+		"Expire(key pkg.String, time_ time.Duration) " +
+			"map[pkg.String]*pkg.User {",
+		"_recv.Store.Expire(key, time_)",
+	} {
+		if !bytes.Contains(src, []byte(want)) {
+			t.Errorf("Generate(%+v): want output containing %q, got:\n%s",
+				cfg, want, src)
+		}
+	}
+}
+
+func TestGenerateBadConfig(t *testing.T) {
+	for _, cfg := range []Config{
+		{},
+		{Type: "T", Func: "f"},
+		{Type: "T", Name: "N"},
+		{Func: "f", Record: map[string]int{"": 1}},
+		{FuncType: "F", Fluent: true},
+		{Wrap: "io.Reader", Pick: map[string]string{"Close": "Reader"}},
+		{
+			Dir:  "../internal/testdata",
+			Type: "M3",
+			Pick: map[string]string{"Close": "Reader"},
+		},
+		{
+			Dir:  "../internal/testdata",
+			Type: "M3",
+			Pick: map[string]string{"Read": "ReadCloser"},
+		},
+		{Func: "now", Proxy: []string{"now"}},
+		{Dir: "../internal/testdata", Type: "M4", Proxy: []string{"Name"}},
+		{Dir: "../internal/testdata", Type: "M4", Proxy: []string{"read"}},
+		{Dir: "../internal/testdata/pkg/cache", Type: "Store"}, // Generic.
+	} {
+		if cfg.Dir != "" {
+			cfg.Packages = testconfig(t).Packages
+		}
+		if _, err := Generate(cfg); err == nil {
+			t.Errorf("Generate(%+v): want error, got <nil>", cfg)
+		}
+	}
+}
+
+func TestGenerateSubpackageType(t *testing.T) {
+	cfg := testconfig(t)
+	cfg.Type = "T0" // Declared in internal/testdata/pkg.
+	_, err := Generate(cfg)
+	if err == nil || err.Error() != "bad type: T0" {
+		t.Errorf("Generate(%+v): want error %q, got %v",
+			cfg, "bad type: T0", err)
+	}
+}
+
+func TestFilename(t *testing.T) {
+	for _, tt := range []struct {
+		cfg  Config
+		want string
+	}{
+		{Config{Type: "M0"}, "mock_m0_test.go"},
+		{Config{Type: "M2", Export: true}, "mock_m2.go"},
+		{Config{Wrap: "io.ReadWriter"}, "mock_read_writer_test.go"},
+		{Config{Wrap: "io.ReadWriter", Name: "FakeRW"}, "mock_fake_rw_test.go"},
+		{Config{Func: "now"}, "mock_func_now_test.go"},
+		{Config{FuncType: "Handler"}, "mock_handler_test.go"},
+		{Config{Type: "M0", Output: "m0_mock_test.go"}, "m0_mock_test.go"},
+	} {
+		if got := tt.cfg.Filename(); got != tt.want {
+			t.Errorf("%+v.Filename(): want %q, got %q", tt.cfg, tt.want, got)
+		}
+	}
+}
+
+func TestSnakecase(t *testing.T) {
+	for _, tt := range []struct{ in, want string }{
+		{"M0", "m0"},
+		{"FakeRW", "fake_rw"},
+		{"HTTPClient", "http_client"},
+		{"ReadWriter", "read_writer"},
+	} {
+		if got := snakecase(tt.in); got != tt.want {
+			t.Errorf("snakecase(%q): want %q, got %q", tt.in, tt.want, got)
+		}
+	}
+}
+
+func TestQualifierPath(t *testing.T) {
+	g := &generator{imports: map[string]string{}, names: map[string]string{}}
+	for _, tt := range []struct{ path, want string }{
+		{"strconv", "strconv"},
+		{"golang.org/x/tools/go/packages", "packages"},
+		{"example.com/mod/v2", "mod"},
+		{"example.com/go-cmp", "go_cmp"},
+		{"example.org/packages", "packages_"},
+	} {
+		if got := g.qualifierpath(tt.path); got != tt.want {
+			t.Errorf("qualifierpath(%q): want %q, got %q",
+				tt.path, tt.want, got)
+		}
+	}
+}
+
+func TestImportBlock(t *testing.T) {
+	imports := map[string]string{
+		"fmt":                 "fmt",
+		"example.com/m/pkg":   "pkg",
+		"example.com/x/mock":  "mock",
+		"lesiw.io/moxie/mock": "mock_",
+		"gopkg.in/yaml.v3":    "yaml",
+	}
+	names := map[string]string{
+		"fmt":                 "fmt",
+		"example.com/m/pkg":   "pkg",
+		"example.com/x/mock":  "mock",
+		"lesiw.io/moxie/mock": "mock",
+		"gopkg.in/yaml.v3":    "yaml",
+	}
+	for _, tt := range []struct {
+		local []string
+		want  string
+	}{{
+		want: "import (\n" +
+			"\t\"fmt\"\n\n" +
+			"\t\"example.com/x/mock\"\n" +
+			"\t\"gopkg.in/yaml.v3\"\n" +
+			"\tmock_ \"lesiw.io/moxie/mock\"\n\n" +
+			"\t\"example.com/m/pkg\"\n" +
+			")",
+	}, {
+		local: []string{"lesiw.io/"},
+		want: "import (\n" +
+			"\t\"fmt\"\n\n" +
+			"\t\"example.com/m/pkg\"\n" +
+			"\t\"example.com/x/mock\"\n" +
+			"\t\"gopkg.in/yaml.v3\"\n\n" +
+			"\tmock_ \"lesiw.io/moxie/mock\"\n" +
+			")",
+	}} {
+		g := &generator{
+			cfg:     Config{Local: tt.local},
+			module:  "example.com/m",
+			imports: imports,
+			names:   names,
+		}
+		if got := g.importblock(); got != tt.want {
+			t.Errorf("importblock() with Local %q:\nwant %s\ngot  %s",
+				tt.local, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+//go:build moxie
+
+package testdata_test
+
+import (
+	"testing"
+
+	"lesiw.io/moxie/internal/testdata"
+	"lesiw.io/moxie/internal/testdata/pkg"
+)
+
+func TestExport(t *testing.T) {
+	var m2 testdata.M2
+	m2.Value_ReturnT(t, 42)
+	if got, want := m2.Value(), 42; int(got) != want {
+		t.Errorf("M2.Value(): want %d, got %d", want, got)
+	}
+	var calls []testdata.M2_Value_Call = m2.Value_Calls()
+	if got, want := len(calls), 1; got != want {
+		t.Errorf("M2.Value_Calls(): want %d calls, got %d", want, got)
+	}
+}
+
+func TestExportAll(t *testing.T) {
+	testdata.M2{}.Value_ReturnAll(t, 7)
+	var m2 testdata.M2
+	if got, want := m2.Value(), 7; int(got) != want {
+		t.Errorf("M2.Value(): want %d, got %d", want, got)
+	}
+}
+
+func TestExportFluent(t *testing.T) {
+	var m2 testdata.M2
+	returnValue(m2.Mock().Value, 3)
+	if got, want := m2.Value(), 3; int(got) != want {
+		t.Errorf("M2.Value(): want %d, got %d", want, got)
+	}
+}
+
+func returnValue(m testdata.M2_Value_Mock, n int) {
+	m.Return(pkg.Int(n))
+}
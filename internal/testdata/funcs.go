@@ -0,0 +1,24 @@
+package testdata
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+var now = time.Now
+
+var join = func(sep string, elems ...string) string {
+	return strings.Join(elems, sep)
+}
+
+type Handler func(ctx context.Context, req string) (string, error)
+
+func serve(h Handler, reqs ...string) error {
+	for _, req := range reqs {
+		if _, err := h(context.Background(), req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package testdata
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestFuncReturn(t *testing.T) {
+	want := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	_now_Return(t, want)
+	if got := now(); !got.Equal(want) {
+		t.Errorf("now(): want %v, got %v", want, got)
+	}
+	if got, want := len(_now_Calls()), 1; got != want {
+		t.Errorf("_now_Calls(): want %d calls, got %d", want, got)
+	}
+	_now_AssertCalls(t, 1)
+}
+
+func TestFuncRestore(t *testing.T) {
+	t.Run("Mock", func(t *testing.T) {
+		_join_Return(t, "mocked")
+		if got, want := join(",", "a", "b"), "mocked"; got != want {
+			t.Errorf("join(): want %q, got %q", want, got)
+		}
+	})
+	if got, want := join(",", "a", "b"), "a,b"; got != want {
+		t.Errorf("join() after test: want %q, got %q", want, got)
+	}
+	if got := _join_Calls(); len(got) != 0 {
+		t.Errorf("_join_Calls() after test: want 0 calls, got %d", len(got))
+	}
+	_join_Stub(t)
+	if join("", "x") != "" {
+		t.Error("join(): want stub result")
+	}
+}
+
+func TestFuncQueue(t *testing.T) {
+	_join_Return(t, "first")
+	_join_Return(t, "second")
+	var got []string
+	for range 3 {
+		got = append(got, join(",", "a", "b"))
+	}
+	_join_Do(t, nil)
+	got = append(got, join(",", "a", "b"))
+	want := []string{"first", "second", "second", "a,b"}
+	if !slices.Equal(want, got) {
+		t.Errorf("join(): want %v, got %v", want, got)
+	}
+	calls := _join_Calls()
+	if len(calls) != 4 {
+		t.Fatalf("_join_Calls(): want 4 calls, got %d", len(calls))
+	}
+	if want := []string{"a", "b"}; calls[0].Sep != "," ||
+		!slices.Equal(calls[0].Elems, want) {
+		t.Errorf("_join_Calls()[0]: got %+v", calls[0])
+	}
+}
+
+func TestFuncDoAll(t *testing.T) {
+	_join_Do(t, func(string, ...string) string { return "first" })
+	_join_DoAll(t, func(string, ...string) string { return "second" })
+	for _, want := range []string{"first", "second", "second"} {
+		if got := join(",", "a"); got != want {
+			t.Errorf("join(): want %q, got %q", want, got)
+		}
+	}
+}
+
+func TestFuncType(t *testing.T) {
+	h, m := _Handler_New()
+	m._Return("ok", nil)
+	m._Return("", errors.New("fail"))
+	if err := serve(h, "a", "b", "c"); err == nil {
+		t.Error("serve(): want error, got <nil>")
+	}
+	var reqs []string
+	for _, call := range m._Calls() {
+		reqs = append(reqs, call.Req)
+	}
+	if want := []string{"a", "b"}; !slices.Equal(want, reqs) {
+		t.Errorf("_Handler_New() calls: want %v, got %v", want, reqs)
+	}
+}
+
+func TestFuncTypeUnmocked(t *testing.T) {
+	h, _ := _Handler_New()
+	defer func() {
+		if got, want := recover(), "Handler: not mocked"; got != want {
+			t.Errorf("recover(): want %q, got %v", want, got)
+		}
+	}()
+	_, _ = h(t.Context(), "")
+}
@@ -1,5 +1,9 @@
 module lesiw.io/moxie/internal/testdata
 
-go 1.23.6
+go 1.25.0
 
 require github.com/google/go-cmp v0.7.0
+
+require lesiw.io/moxie v0.0.0
+
+replace lesiw.io/moxie => ../..
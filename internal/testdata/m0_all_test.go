@@ -2,6 +2,8 @@ package testdata
 
 import (
 	"errors"
+	"fmt"
+	"runtime"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -120,3 +122,181 @@ func TestAllInterface(t *testing.T) {
 	new(M0)._Read_StubAll(t)
 	_, _ = m0.Read(nil) // Validate this does not panic.
 }
+
+func TestResetAll(t *testing.T) {
+	var m0 M0
+	t.Cleanup(func() { pkg.SimpleCalled = false })
+	t.Run("TestResetAllSubTest", func(t *testing.T) {
+		t.Cleanup(func() { pkg.SimpleCalled = false })
+		new(M0)._Simple_StubAll(t)
+		new(M0)._OneResult_ReturnAll(t, errors.New("error result"))
+		new(M0)._M0_ResetAll()
+		m0.Simple()
+		if !pkg.SimpleCalled {
+			t.Error("want Simple() call, got mock")
+		}
+		if got, want := m0.OneResult(), error(nil); want != got {
+			t.Errorf("M0.OneResult(): want %v, got %v", want, got)
+		}
+	})
+}
+
+func TestBubbleAll(t *testing.T) {
+	t.Run("TestBubbleAllSubTest", func(t *testing.T) {
+		new(M0)._M0_BubbleAll(t)
+		new(M0).OneParamNoResult("call one")
+		new(M0).TwoParamsNoResult("call two", "call three")
+		if got := len(new(M0)._OneParamNoResult_AllCalls()); got != 1 {
+			t.Errorf("M0._OneParamNoResult_AllCalls(): want 1 call, got %d",
+				got)
+		}
+		if got := len(new(M0)._TwoParamsNoResult_AllCalls()); got != 1 {
+			t.Errorf("M0._TwoParamsNoResult_AllCalls(): want 1 call, got %d",
+				got)
+		}
+	})
+	if got := len(new(M0)._OneParamNoResult_AllCalls()); got != 0 {
+		t.Errorf("M0._OneParamNoResult_AllCalls() did not reset")
+	}
+	if got := len(new(M0)._TwoParamsNoResult_AllCalls()); got != 0 {
+		t.Errorf("M0._TwoParamsNoResult_AllCalls() did not reset")
+	}
+}
+
+type parallelTB struct {
+	testing.TB
+	fatal string
+}
+
+func (*parallelTB) Helper() {}
+
+func (*parallelTB) Setenv(string, string) {
+	panic("testing: t.Setenv called after t.Parallel")
+}
+
+func (tb *parallelTB) Fatalf(format string, args ...any) {
+	tb.fatal = fmt.Sprintf(format, args...)
+	runtime.Goexit()
+}
+
+func TestParallelAll(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func(testing.TB)
+		want string
+	}{{
+		name: "StubAll",
+		fn:   new(M0)._Simple_StubAll,
+		want: "M0.Simple: global mocks cannot be used in parallel tests",
+	}, {
+		name: "BubbleCalls",
+		fn:   new(M0)._Simple_BubbleCalls,
+		want: "M0.Simple: global mocks cannot be used in parallel tests",
+	}, {
+		name: "BubbleAll",
+		fn:   new(M0)._M0_BubbleAll,
+		want: "M0: global mocks cannot be used in parallel tests",
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Cleanup(func() { pkg.SimpleCalled = false })
+			tb := &parallelTB{TB: t}
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				tt.fn(tb)
+			}()
+			<-done
+			if got := tb.fatal; got != tt.want {
+				t.Errorf("fatal: want %q, got %q", tt.want, got)
+			}
+			new(M0).Simple()
+			if !pkg.SimpleCalled {
+				t.Error("want Simple() call, got mock")
+			}
+		})
+	}
+}
+
+func TestRecordOff(t *testing.T) {
+	var m0 M0
+	t.Run("TestRecordOffSubTest", func(t *testing.T) {
+		t.Cleanup(func() { pkg.SimpleCalled = false })
+		new(M0)._M0_RecordAll(t, 0)
+		m0.Simple()
+		if !pkg.SimpleCalled {
+			t.Error("want Simple() call, got mock")
+		}
+		pkg.SimpleCalled = false
+		m0._Simple_Stub()
+		m0.Simple()
+		if pkg.SimpleCalled {
+			t.Error("want mock, got Simple() call")
+		}
+		if got := len(m0._Simple_Calls()); got != 0 {
+			t.Errorf("M0._Simple_Calls(): want 0 calls, got %d", got)
+		}
+	})
+	m0.OneParamNoResult("call")
+	if got := len(m0._OneParamNoResult_Calls()); got != 1 {
+		t.Errorf("M0._OneParamNoResult_Calls(): want 1 call, got %d", got)
+	}
+}
+
+func TestRecordOffBubble(t *testing.T) {
+	var m0 M0
+	t.Run("TestRecordOffBubbleSubTest", func(t *testing.T) {
+		new(M0)._M0_RecordAll(t, 0)
+		new(M0)._OneParamNoResult_BubbleCalls(t)
+		for range 5 {
+			m0.OneParamNoResult("call")
+		}
+		if got := len(m0._OneParamNoResult_Calls()); got != 0 {
+			t.Errorf("M0._OneParamNoResult_Calls(): want 0 calls, got %d",
+				got)
+		}
+		if got := len(new(M0)._OneParamNoResult_AllCalls()); got != 0 {
+			t.Errorf("M0._OneParamNoResult_AllCalls(): want 0 calls, got %d",
+				got)
+		}
+	})
+}
+
+func TestRecordLimit(t *testing.T) {
+	var m0 M0
+	t.Run("TestRecordLimitSubTest", func(t *testing.T) {
+		new(M0)._OneParamNoResult_Record(t, 2)
+		new(M0)._OneParamNoResult_BubbleCalls(t)
+		m0.OneParamNoResult("call one")
+		m0.OneParamNoResult("call two")
+		m0.OneParamNoResult("call three")
+		want := []_M0_OneParamNoResult_Call{
+			{"call two"},
+			{"call three"},
+		}
+		opt := cmpopts.EquateComparable(_M0_OneParamNoResult_Call{})
+		got := m0._OneParamNoResult_Calls()
+		if !cmp.Equal(want, got, opt) {
+			t.Errorf("M0._OneParamNoResult_Calls():\n%s",
+				cmp.Diff(want, got, opt),
+			)
+		}
+		got = new(M0)._OneParamNoResult_AllCalls()
+		if !cmp.Equal(want, got, opt) {
+			t.Errorf("M0._OneParamNoResult_AllCalls():\n%s",
+				cmp.Diff(want, got, opt),
+			)
+		}
+	})
+	m0.OneParamNoResult("call four")
+	if got := len(m0._OneParamNoResult_Calls()); got != 3 {
+		t.Errorf("M0._OneParamNoResult_Calls(): want 3 calls, got %d", got)
+	}
+}
+
+func TestAllCallsOptIn(t *testing.T) {
+	new(M0).OneParamNoResult("call")
+	if got := len(new(M0)._OneParamNoResult_AllCalls()); got != 0 {
+		t.Errorf("M0._OneParamNoResult_AllCalls(): want 0 calls, got %d", got)
+	}
+}
@@ -0,0 +1,35 @@
+package testdata
+
+import "testing"
+
+func BenchmarkDirect(b *testing.B) {
+	var m0 M0
+	for range b.N {
+		_ = m0.T0.OneParamOneResult("")
+	}
+}
+
+func BenchmarkProxy(b *testing.B) {
+	new(M0)._M0_BubbleAll(b)
+	var m0 M0
+	for range b.N {
+		_ = m0.OneParamOneResult("")
+	}
+}
+
+func BenchmarkProxyNoRecord(b *testing.B) {
+	new(M0)._M0_RecordAll(b, 0)
+	var m0 M0
+	for range b.N {
+		_ = m0.OneParamOneResult("")
+	}
+}
+
+func BenchmarkProxyMocked(b *testing.B) {
+	new(M0)._M0_RecordAll(b, 0)
+	var m0 M0
+	m0._OneParamOneResult_Return(nil)
+	for range b.N {
+		_ = m0.OneParamOneResult("")
+	}
+}
@@ -0,0 +1,89 @@
+package testdata
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestReturnCtx(t *testing.T) {
+	var m0 M0
+	want := errors.New("error result")
+	ctx := new(M0)._ContextOneResult_ReturnCtx(t.Context(), want)
+	if got := m0.ContextOneResult(ctx, ""); want != got {
+		t.Errorf("M0.ContextOneResult() call #1: want %v, got %v", want, got)
+	}
+	// Calling a second time should return the same result.
+	if got := m0.ContextOneResult(ctx, ""); want != got {
+		t.Errorf("M0.ContextOneResult() call #2: want %v, got %v", want, got)
+	}
+	// Calling with another context should not be mocked.
+	if got := m0.ContextOneResult(t.Context(), ""); got != nil {
+		t.Errorf("M0.ContextOneResult() call #3: want <nil>, got %v", got)
+	}
+}
+
+func TestReturnCtxQueue(t *testing.T) {
+	var m0 M0
+	err1 := errors.New("error one")
+	err2 := errors.New("error two")
+	ctx := new(M0)._ContextOneResult_ReturnCtx(t.Context(), err1)
+	ctx = new(M0)._ContextOneResult_ReturnCtx(ctx, err2)
+	if got := m0.ContextOneResult(ctx, ""); err1 != got {
+		t.Errorf("M0.ContextOneResult() call #1: want %v, got %v", err1, got)
+	}
+	if got := m0.ContextOneResult(ctx, ""); err2 != got {
+		t.Errorf("M0.ContextOneResult() call #2: want %v, got %v", err2, got)
+	}
+	if got := m0.ContextOneResult(ctx, ""); err2 != got {
+		t.Errorf("M0.ContextOneResult() call #3: want %v, got %v", err2, got)
+	}
+}
+
+func TestUnmockCtx(t *testing.T) {
+	var m0 M0
+	ctx := new(M0)._ContextTwoResults_ReturnCtx(t.Context(), 42, nil)
+	ctx = new(M0)._ContextTwoResults_DoCtx(ctx, nil)
+	if got, _ := m0.ContextTwoResults(ctx); got != 0 {
+		t.Errorf("M0.ContextTwoResults(): want 0, got %d", got)
+	}
+}
+
+func TestNilCtx(t *testing.T) {
+	var m0 M0
+	m0.ContextNoResult(nil) // Validate this does not panic.
+}
+
+func TestReturnCtxParallel(t *testing.T) {
+	for _, want := range []error{
+		errors.New("error one"),
+		errors.New("error two"),
+		errors.New("error three"),
+	} {
+		t.Run(want.Error(), func(t *testing.T) {
+			t.Parallel()
+			var m0 M0
+			ctx := new(M0)._ContextOneResult_ReturnCtx(t.Context(), want)
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			for range 100 {
+				if got := m0.ContextOneResult(ctx, ""); want != got {
+					t.Fatalf("M0.ContextOneResult(): want %v, got %v",
+						want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestInstanceBeforeCtx(t *testing.T) {
+	var m0 M0
+	want := errors.New("instance")
+	m0._ContextOneResult_Return(want)
+	ctx := new(M0)._ContextOneResult_ReturnCtx(
+		t.Context(), errors.New("context"),
+	)
+	if got := m0.ContextOneResult(ctx, ""); want != got {
+		t.Errorf("M0.ContextOneResult(): want %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,59 @@
+package testdata
+
+import (
+	"errors"
+	"testing"
+
+	"lesiw.io/moxie/internal/testdata/pkg"
+)
+
+func TestFluentReturn(t *testing.T) {
+	var m0 M0
+	m0.Mock().TwoResults.Return(42, nil)
+	if got, _ := m0.TwoResults(); got != 42 {
+		t.Errorf("M0.TwoResults(): want 42, got %d", got)
+	}
+	if got, want := len(m0.Mock().TwoResults.Calls()), 1; got != want {
+		t.Errorf("M0.Mock().TwoResults.Calls(): want %d calls, got %d",
+			want, got)
+	}
+}
+
+func TestFluentHelper(t *testing.T) {
+	var m0 M0
+	failWrites(m0.Mock())
+	if _, err := m0.Write(nil); err == nil {
+		t.Error("M0.Write(): want error, got <nil>")
+	}
+}
+
+func failWrites(m *_M0Mock) {
+	m.Write.Return(0, errors.New("write failed"))
+}
+
+func TestFluentAll(t *testing.T) {
+	var m0 M0
+	m0.Mock().OneParamOneResult.All(t).Bubble()
+	m0.Mock().OneParamOneResult.All(t).Stub()
+	_ = new(M0).OneParamOneResult("a")
+	_ = new(M0).OneParamOneResult("b")
+	calls := m0.Mock().OneParamOneResult.All(t).Calls()
+	want := []pkg.String{"a", "b"}
+	if len(calls) != len(want) {
+		t.Fatalf("All(t).Calls(): want %d calls, got %d", len(want), len(calls))
+	}
+	for i, call := range calls {
+		if call.P0 != want[i] {
+			t.Errorf("All(t).Calls()[%d]: want %q, got %q", i, want[i], call.P0)
+		}
+	}
+}
+
+func TestFluentCtx(t *testing.T) {
+	var m0 M0
+	want := errors.New("error result")
+	ctx := m0.Mock().ContextOneResult.ReturnCtx(t.Context(), want)
+	if got := m0.ContextOneResult(ctx, ""); want != got {
+		t.Errorf("M0.ContextOneResult(): want %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,57 @@
+package testdata
+
+import (
+	"errors"
+	"testing"
+
+	"lesiw.io/moxie/internal/testdata/pkg"
+)
+
+func BenchmarkStubAll(b *testing.B) {
+	b.Cleanup(func() { pkg.SimpleCalled = false })
+	new(M0)._Simple_StubAll(b)
+	new(M0)._Simple_BubbleCalls(b)
+	var m0 M0
+	for range b.N {
+		m0.Simple()
+	}
+	if pkg.SimpleCalled {
+		b.Error("want mock, got Simple() call")
+	}
+}
+
+func BenchmarkReturnAll(b *testing.B) {
+	want := errors.New("error result")
+	new(M0)._OneResult_ReturnAll(b, want)
+	var m0 M0
+	for range b.N {
+		if got := m0.OneResult(); want != got {
+			b.Fatalf("M0.OneResult(): want %v, got %v", want, got)
+		}
+	}
+}
+
+func FuzzReturnAll(f *testing.F) {
+	f.Add("error result")
+	f.Fuzz(func(t *testing.T, s string) {
+		want := errors.New(s)
+		new(M0)._OneResult_ReturnAll(t, want)
+		var m0 M0
+		if got := m0.OneResult(); want != got {
+			t.Errorf("M0.OneResult(): want %v, got %v", want, got)
+		}
+	})
+}
+
+func FuzzStubAll(f *testing.F) {
+	f.Cleanup(func() { pkg.SimpleCalled = false })
+	new(M0)._Simple_StubAll(f)
+	f.Add(1)
+	f.Fuzz(func(t *testing.T, _ int) {
+		var m0 M0
+		m0.Simple()
+		if pkg.SimpleCalled {
+			t.Error("want mock, got Simple() call")
+		}
+	})
+}
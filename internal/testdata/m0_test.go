@@ -2,6 +2,7 @@ package testdata
 
 import (
 	"errors"
+	"runtime"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -96,3 +97,113 @@ func TestInterface(t *testing.T) {
 	m0._Read_Stub()
 	_, _ = m0.Read(nil) // Validate this does not panic.
 }
+
+func TestStubT(t *testing.T) {
+	var m0 M0
+	t.Cleanup(func() { pkg.SimpleCalled = false })
+	t.Run("TestStubTSubTest", func(t *testing.T) {
+		t.Cleanup(func() { pkg.SimpleCalled = false })
+		m0._Simple_StubT(t)
+		m0.Simple()
+		if pkg.SimpleCalled {
+			t.Error("want mock, got Simple() call")
+		}
+	})
+	if got := len(m0._Simple_Calls()); got != 0 {
+		t.Errorf("M0._Simple_Calls(): want 0 calls, got %d", got)
+	}
+	m0.Simple()
+	if !pkg.SimpleCalled {
+		t.Errorf("want Simple() call, got mock")
+	}
+}
+
+func TestReturnT(t *testing.T) {
+	var m0 M0
+	t.Run("TestReturnTSubTest", func(t *testing.T) {
+		want := errors.New("error result")
+		m0._OneResult_ReturnT(t, want)
+		if got := m0.OneResult(); want != got {
+			t.Errorf("M0.OneResult() call #1: want %v, got %v", want, got)
+		}
+	})
+	if got, want := m0.OneResult(), error(nil); want != got {
+		t.Errorf("M0.OneResult() call #2: want %v, got %v", want, got)
+	}
+}
+
+func TestReset(t *testing.T) {
+	t.Cleanup(func() { pkg.SimpleCalled = false })
+	var m0 M0
+	m0._Simple_Stub()
+	m0._OneResult_Return(errors.New("error result"))
+	m0.Simple()
+	_ = m0.OneResult()
+	m0._M0_Reset()
+	if got := len(m0._Simple_Calls()); got != 0 {
+		t.Errorf("M0._Simple_Calls(): want 0 calls, got %d", got)
+	}
+	if got := len(m0._OneResult_Calls()); got != 0 {
+		t.Errorf("M0._OneResult_Calls(): want 0 calls, got %d", got)
+	}
+	m0.Simple()
+	if !pkg.SimpleCalled {
+		t.Error("want Simple() call, got mock")
+	}
+	if got, want := m0.OneResult(), error(nil); want != got {
+		t.Errorf("M0.OneResult(): want %v, got %v", want, got)
+	}
+}
+
+func TestInteriorPointer(t *testing.T) {
+	t.Cleanup(func() { pkg.SimpleCalled = false })
+	var s struct {
+		_  [16]byte
+		m0 M0
+	}
+	s.m0._Simple_Stub()
+	s.m0.Simple()
+	if pkg.SimpleCalled {
+		t.Error("want mock, got Simple() call")
+	}
+}
+
+func TestArrayElement(t *testing.T) {
+	t.Cleanup(func() { pkg.SimpleCalled = false })
+	var a [3]M0
+	a[1]._Simple_Stub()
+	a[0].Simple()
+	if !pkg.SimpleCalled {
+		t.Error("want Simple() call, got mock")
+	}
+	pkg.SimpleCalled = false
+	a[1].Simple()
+	if pkg.SimpleCalled {
+		t.Error("want mock, got Simple() call")
+	}
+}
+
+func TestSliceElement(t *testing.T) {
+	s := make([]M0, 3)
+	s[2]._OneParamNoResult_Stub()
+	s[2].OneParamNoResult("call")
+	if got := len(s[2]._OneParamNoResult_Calls()); got != 1 {
+		t.Errorf("M0._OneParamNoResult_Calls(): want 1 call, got %d", got)
+	}
+	if got := len(s[1]._OneParamNoResult_Calls()); got != 0 {
+		t.Errorf("M0._OneParamNoResult_Calls(): want 0 calls, got %d", got)
+	}
+}
+
+func TestAddressReuse(t *testing.T) {
+	t.Cleanup(func() { pkg.SimpleCalled = false })
+	for range 100 {
+		m0 := new(M0)
+		if got := len(m0._Simple_Calls()); got != 0 {
+			t.Fatalf("M0._Simple_Calls(): want 0 calls, got %d", got)
+		}
+		m0._Simple_Stub()
+		m0.Simple()
+		runtime.GC()
+	}
+}
@@ -0,0 +1,12 @@
+package testdata
+
+import (
+	"lesiw.io/moxie/internal/testdata/pkg"
+	"lesiw.io/moxie/mock/state"
+)
+
+// M1 keeps its mock state in a field, so copies share mocks and calls.
+type M1 struct {
+	pkg.T1
+	moxie state.State
+}
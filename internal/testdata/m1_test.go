@@ -0,0 +1,99 @@
+package testdata
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestStateCopy(t *testing.T) {
+	var m1 M1
+	m1._Value_Return(42)
+	m1.Value()
+	cp := m1
+	if got, want := cp.Value(), 42; int(got) != want {
+		t.Errorf("M1.Value(): want %d, got %d", want, got)
+	}
+	if got, want := len(cp._Value_Calls()), 2; got != want {
+		t.Errorf("M1._Value_Calls(): want %d calls, got %d", want, got)
+	}
+	if got, want := len(m1._Value_Calls()), 2; got != want {
+		t.Errorf("M1._Value_Calls(): want %d calls, got %d", want, got)
+	}
+}
+
+func TestStateCopyBeforeUse(t *testing.T) {
+	var m1 M1
+	cp := m1
+	m1._Value_Return(42)
+	if got, want := cp.Value(), 1; int(got) != want {
+		t.Errorf("M1.Value() on early copy: want %d, got %d", want, got)
+	}
+	if got, want := len(m1._Value_Calls()), 0; got != want {
+		t.Errorf("M1._Value_Calls(): want %d calls, got %d", want, got)
+	}
+}
+
+func TestStateMap(t *testing.T) {
+	var m1 M1
+	m1._Value_Return(42)
+	m := map[string]M1{"m1": m1}
+	m1 = m["m1"]
+	if got, want := m1.Value(), 42; int(got) != want {
+		t.Errorf("M1.Value(): want %d, got %d", want, got)
+	}
+}
+
+func TestStateUnmocked(t *testing.T) {
+	var m1 M1
+	if got, want := m1.Value(), 1; int(got) != want {
+		t.Errorf("M1.Value(): want %d, got %d", want, got)
+	}
+	if got, want := len(m1._Value_Calls()), 1; got != want {
+		t.Errorf("M1._Value_Calls(): want %d calls, got %d", want, got)
+	}
+}
+
+func TestStateAll(t *testing.T) {
+	var m1 M1
+	t.Run("TestStateAllSubTest", func(t *testing.T) {
+		new(M1)._Value_ReturnAll(t, 42)
+		if got, want := m1.Value(), 42; int(got) != want {
+			t.Errorf("M1.Value(): want %d, got %d", want, got)
+		}
+	})
+	if got, want := m1.Value(), 1; int(got) != want {
+		t.Errorf("M1.Value(): want %d, got %d", want, got)
+	}
+}
+
+func TestStateRecordLimit(t *testing.T) {
+	var m1 M1
+	for range 3 {
+		m1.Value()
+	}
+	if got, want := len(m1._Value_Calls()), 2; got != want {
+		t.Errorf("M1._Value_Calls(): want %d calls, got %d", want, got)
+	}
+}
+
+func TestTemplateHelper(t *testing.T) {
+	var m1 M1
+	m1.Value()
+	m1._Value_AssertCalls(t, 1)
+}
+
+func TestStateConcurrent(t *testing.T) {
+	new(M1)._M1_BubbleAll(t)
+	new(M1)._M1_RecordAll(t, -1)
+	var (
+		m1 M1
+		wg sync.WaitGroup
+	)
+	for range 8 {
+		wg.Go(func() { m1.Value() })
+	}
+	wg.Wait()
+	if got, want := len(m1._Value_Calls()), 8; got != want {
+		t.Errorf("M1._Value_Calls(): want %d calls, got %d", want, got)
+	}
+}
@@ -0,0 +1,6 @@
+package testdata
+
+import "lesiw.io/moxie/internal/testdata/pkg"
+
+// M2 has exported mocks for use from other packages.
+type M2 struct{ pkg.T1 }
@@ -0,0 +1,9 @@
+package testdata
+
+import "io"
+
+// M3 embeds two types that both have a Close method.
+type M3 struct {
+	io.ReadCloser
+	io.WriteCloser
+}
@@ -0,0 +1,44 @@
+package testdata
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+type closer struct {
+	io.Reader
+	io.Writer
+	closed bool
+}
+
+func (c *closer) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestPickDelegates(t *testing.T) {
+	r, w := &closer{Reader: strings.NewReader("")}, &closer{Writer: io.Discard}
+	m3 := M3{ReadCloser: r, WriteCloser: w}
+	if err := m3.Close(); err != nil {
+		t.Fatalf("M3.Close(): %v", err)
+	}
+	if !r.closed || w.closed {
+		t.Errorf("M3.Close(): want ReadCloser closed only, got %v and %v",
+			r.closed, w.closed)
+	}
+}
+
+func TestPickMock(t *testing.T) {
+	w := &closer{Writer: io.Discard}
+	m3 := M3{ReadCloser: &closer{}, WriteCloser: w}
+	want := errors.New("close failed")
+	m3._Close_Return(want)
+	if got := m3.Close(); got != want {
+		t.Errorf("M3.Close(): want %v, got %v", want, got)
+	}
+	if err := m3.WriteCloser.Close(); err != nil || !w.closed {
+		t.Errorf("M3.WriteCloser.Close(): want closed, got %v", err)
+	}
+}
@@ -0,0 +1,21 @@
+package testdata
+
+import (
+	"errors"
+	"io"
+)
+
+// M4 declares methods of its own alongside those it embeds.
+type M4 struct{ io.ReadCloser }
+
+func (M4) Name() string { return "m4" }
+
+// Close hides the Close method of the embedded io.ReadCloser.
+func (M4) Close() error { return nil }
+
+func (*M4) validate(s string) error {
+	if s == "" {
+		return errors.New("empty")
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package testdata
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestProxyDelegates(t *testing.T) {
+	var m4 M4
+	if err := m4.Validate(""); err == nil {
+		t.Error("M4.Validate(\"\"): want error, got <nil>")
+	}
+	if got, want := m4.Name(), "m4"; got != want {
+		t.Errorf("M4.Name(): want %q, got %q", want, got)
+	}
+}
+
+func TestProxyMock(t *testing.T) {
+	var m4 M4
+	want := errors.New("invalid")
+	m4._Validate_Return(want)
+	if got := m4.Validate("ok"); got != want {
+		t.Errorf("M4.Validate(\"ok\"): want %v, got %v", want, got)
+	}
+	if got, want := len(m4._Validate_Calls()), 1; got != want {
+		t.Errorf("M4._Validate_Calls(): want %d calls, got %d", want, got)
+	}
+}
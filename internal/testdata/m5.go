@@ -0,0 +1,8 @@
+//go:build tagged
+
+package testdata
+
+import "io"
+
+// M5 is only built with the tagged build tag.
+type M5 struct{ io.Reader }
@@ -0,0 +1,16 @@
+//go:build tagged
+
+package testdata
+
+import (
+	"io"
+	"testing"
+)
+
+func TestTagged(t *testing.T) {
+	var m5 M5
+	m5._Read_Return(0, io.EOF)
+	if _, err := m5.Read(nil); err != io.EOF {
+		t.Errorf("M5.Read(): want %v, got %v", io.EOF, err)
+	}
+}
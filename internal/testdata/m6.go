@@ -0,0 +1,11 @@
+package testdata
+
+import (
+	"lesiw.io/moxie/internal/testdata/pkg"
+	"lesiw.io/moxie/internal/testdata/pkg/cache"
+)
+
+// M6 embeds a generic type instantiated with types from another package.
+type M6 struct {
+	cache.Store[pkg.String, *pkg.User]
+}
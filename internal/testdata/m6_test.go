@@ -0,0 +1,49 @@
+package testdata
+
+import (
+	"testing"
+
+	"lesiw.io/moxie/internal/testdata/pkg"
+)
+
+func TestGenericDelegates(t *testing.T) {
+	var m6 M6
+	want := &pkg.User{Name: "gopher"}
+	m6.Put("gopher", want)
+	if got, ok := m6.Get("gopher"); !ok || got != want {
+		t.Errorf("M6.Get(%q): want %v, true, got %v, %v",
+			"gopher", want, got, ok)
+	}
+	keys := m6.Keys(func(k pkg.String) bool { return k != "" })
+	if len(keys) != 1 || keys[0] != "gopher" {
+		t.Errorf("M6.Keys(): want [gopher], got %v", keys)
+	}
+}
+
+func TestGenericMock(t *testing.T) {
+	var m6 M6
+	want := &pkg.User{Name: "mock"}
+	m6._Get_Return(want, true)
+	if got, ok := m6.Get("any"); !ok || got != want {
+		t.Errorf("M6.Get(%q): want %v, true, got %v, %v", "any", want, got, ok)
+	}
+	m6._Each_Do(func(fn func(pkg.String, *pkg.User) bool) {
+		fn("mock", want)
+	})
+	var got []pkg.String
+	m6.Each(func(key pkg.String, _ *pkg.User) bool {
+		got = append(got, key)
+		return true
+	})
+	if len(got) != 1 || got[0] != "mock" {
+		t.Errorf("M6.Each(): want [mock], got %v", got)
+	}
+	m6._Keys_Stub()
+	if keys := m6.Keys(nil, nil); keys != nil {
+		t.Errorf("M6.Keys(): want nil, got %v", keys)
+	}
+	if calls := m6._Keys_Calls(); len(calls) != 1 ||
+		len(calls[0].Filters) != 2 {
+		t.Errorf("M6._Keys_Calls(): want 1 call with 2 filters, got %v", calls)
+	}
+}
@@ -0,0 +1,6 @@
+package testdata
+
+import "io"
+
+// M7 is only built on linux, by its file name.
+type M7 struct{ io.Reader }
@@ -0,0 +1,14 @@
+package testdata
+
+// Logger is safe to use through a nil pointer.
+type Logger struct{ lines []string }
+
+func (l *Logger) Log(line string) {
+	if l == nil {
+		return
+	}
+	l.lines = append(l.lines, line)
+}
+
+// M8 embeds a pointer whose methods accept a nil receiver.
+type M8 struct{ *Logger }
@@ -0,0 +1,16 @@
+package testdata
+
+import "testing"
+
+func TestNilPointerEmbed(t *testing.T) {
+	var m8 M8
+	m8.Log("dropped")
+	if got := len(m8._Log_Calls()); got != 1 {
+		t.Errorf("M8._Log_Calls(): want 1 call, got %d", got)
+	}
+	m8.Logger = new(Logger)
+	m8.Log("kept")
+	if got, want := len(m8.lines), 1; got != want {
+		t.Errorf("M8.lines: want %d lines, got %d", want, got)
+	}
+}
@@ -0,0 +1,246 @@
+// Code generated by lesiw.io/moxie. DO NOT EDIT.
+
+package testdata
+
+import (
+	"io"
+	"testing"
+
+	"lesiw.io/moxie/mock"
+)
+
+// FakeRW embeds io.ReadWriter so that its methods can be mocked.
+type FakeRW struct {
+	io.ReadWriter
+}
+
+var (
+	_FakeRW       mock.Registry[FakeRW, _FakeRWData]
+	_FakeRWPolicy _FakeRWPolicies
+)
+
+type _FakeRWData struct {
+	Read  mock.Method[func([]byte) (int, error), _FakeRW_Read_Call]
+	Write mock.Method[func([]byte) (int, error), _FakeRW_Write_Call]
+}
+
+type _FakeRWPolicies struct {
+	Read  mock.Policy
+	Write mock.Policy
+}
+
+func _FakeRWEntry(t *FakeRW) *mock.Entry[_FakeRWData] {
+	return _FakeRW.Get(t)
+}
+
+func _FakeRWInstance(t *FakeRW, name string) *mock.Entry[_FakeRWData] {
+	if t == nil {
+		panic(name + ": nil pointer receiver")
+	}
+	return _FakeRWEntry(t)
+}
+
+func (_recv *FakeRW) _FakeRW_Reset() {
+	_FakeRWInstance(_recv, "FakeRW").Reset()
+}
+
+func (FakeRW) _FakeRW_ResetAll() {
+	_FakeRW.Global().Reset()
+}
+
+func (FakeRW) _FakeRW_BubbleAll(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "FakeRW")
+	mock.Bubble(t, _FakeRW.Global(), (*_FakeRWData).clearCalls, _FakeRWPolicy.all()...)
+}
+
+func (FakeRW) _FakeRW_RecordAll(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "FakeRW")
+	for _, p := range _FakeRWPolicy.all() {
+		p.Record(t, limit)
+	}
+}
+
+func (_dat *_FakeRWData) clearCalls() {
+	_dat.Read.Calls.Clear()
+	_dat.Write.Calls.Clear()
+}
+
+func (_p *_FakeRWPolicies) all() []*mock.Policy {
+	return []*mock.Policy{
+		&_p.Read,
+		&_p.Write,
+	}
+}
+
+type _FakeRW_Read_Call struct {
+	P []byte
+}
+type _FakeRW_Write_Call struct {
+	P []byte
+}
+
+func (_dat *_FakeRWData) _Read() *mock.Method[func([]byte) (int, error), _FakeRW_Read_Call] {
+	return &_dat.Read
+}
+
+func (_recv *FakeRW) Read(p []byte) (int, error) {
+	if _recv == nil {
+		panic("FakeRW.Read: nil pointer receiver")
+	}
+	if _FakeRW.Idle(&_FakeRWPolicy.Read) &&
+		_recv.ReadWriter != nil {
+		return _recv.ReadWriter.Read(p)
+	}
+	_fn, _ok := mock.Next(_FakeRWEntry(_recv), _FakeRW.Global(), (*_FakeRWData)._Read, &_FakeRWPolicy.Read, _FakeRW_Read_Call{p})
+	if !_ok {
+		if _recv.ReadWriter == nil {
+			panic("FakeRW.Read: not mocked and embedded io.ReadWriter is nil")
+		}
+		_fn = _recv.ReadWriter.Read
+	}
+	return _fn(p)
+}
+
+func (_recv *FakeRW) _Read_Do(fn func([]byte) (int, error)) {
+	mock.Do(_FakeRWInstance(_recv, "FakeRW.Read"), (*_FakeRWData)._Read, fn)
+}
+
+func (_recv *FakeRW) _Read_DoT(t testing.TB, fn func([]byte) (int, error)) {
+	mock.DoT(t, _FakeRWInstance(_recv, "FakeRW.Read"), (*_FakeRWData)._Read, fn)
+}
+
+func (FakeRW) _Read_DoAll(t testing.TB, fn func([]byte) (int, error)) {
+	t.Helper()
+	mock.Serial(t, "FakeRW.Read")
+	mock.DoAll(t, _FakeRW.Global(), (*_FakeRWData)._Read, fn)
+}
+
+func (_recv *FakeRW) _Read_Stub() {
+	_recv._Read_Do(func([]byte) (n int, err error) { return })
+}
+
+func (_recv *FakeRW) _Read_StubT(t testing.TB) {
+	_recv._Read_DoT(t, func([]byte) (n int, err error) { return })
+}
+
+func (FakeRW) _Read_StubAll(t testing.TB) {
+	t.Helper()
+	new(FakeRW)._Read_DoAll(t, func([]byte) (n int, err error) { return })
+}
+
+func (_recv *FakeRW) _Read_Return(n int, err error) {
+	_recv._Read_Do(func([]byte) (int, error) { return n, err })
+}
+
+func (_recv *FakeRW) _Read_ReturnT(t testing.TB, n int, err error) {
+	_recv._Read_DoT(t, func([]byte) (int, error) { return n, err })
+}
+
+func (FakeRW) _Read_ReturnAll(t testing.TB, n int, err error) {
+	t.Helper()
+	new(FakeRW)._Read_DoAll(t, func([]byte) (int, error) { return n, err })
+}
+
+func (_recv *FakeRW) _Read_Calls() []_FakeRW_Read_Call {
+	return mock.Calls(_FakeRWInstance(_recv, "FakeRW.Read"), (*_FakeRWData)._Read)
+}
+
+func (FakeRW) _Read_AllCalls() []_FakeRW_Read_Call {
+	return mock.Calls(_FakeRW.Global(), (*_FakeRWData)._Read)
+}
+
+func (FakeRW) _Read_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "FakeRW.Read")
+	mock.Bubble(t, _FakeRW.Global(), func(_dat *_FakeRWData) { _dat.Read.Calls.Clear() }, &_FakeRWPolicy.Read)
+}
+
+func (FakeRW) _Read_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "FakeRW.Read")
+	_FakeRWPolicy.Read.Record(t, limit)
+}
+
+func (_dat *_FakeRWData) _Write() *mock.Method[func([]byte) (int, error), _FakeRW_Write_Call] {
+	return &_dat.Write
+}
+
+func (_recv *FakeRW) Write(p []byte) (int, error) {
+	if _recv == nil {
+		panic("FakeRW.Write: nil pointer receiver")
+	}
+	if _FakeRW.Idle(&_FakeRWPolicy.Write) &&
+		_recv.ReadWriter != nil {
+		return _recv.ReadWriter.Write(p)
+	}
+	_fn, _ok := mock.Next(_FakeRWEntry(_recv), _FakeRW.Global(), (*_FakeRWData)._Write, &_FakeRWPolicy.Write, _FakeRW_Write_Call{p})
+	if !_ok {
+		if _recv.ReadWriter == nil {
+			panic("FakeRW.Write: not mocked and embedded io.ReadWriter is nil")
+		}
+		_fn = _recv.ReadWriter.Write
+	}
+	return _fn(p)
+}
+
+func (_recv *FakeRW) _Write_Do(fn func([]byte) (int, error)) {
+	mock.Do(_FakeRWInstance(_recv, "FakeRW.Write"), (*_FakeRWData)._Write, fn)
+}
+
+func (_recv *FakeRW) _Write_DoT(t testing.TB, fn func([]byte) (int, error)) {
+	mock.DoT(t, _FakeRWInstance(_recv, "FakeRW.Write"), (*_FakeRWData)._Write, fn)
+}
+
+func (FakeRW) _Write_DoAll(t testing.TB, fn func([]byte) (int, error)) {
+	t.Helper()
+	mock.Serial(t, "FakeRW.Write")
+	mock.DoAll(t, _FakeRW.Global(), (*_FakeRWData)._Write, fn)
+}
+
+func (_recv *FakeRW) _Write_Stub() {
+	_recv._Write_Do(func([]byte) (n int, err error) { return })
+}
+
+func (_recv *FakeRW) _Write_StubT(t testing.TB) {
+	_recv._Write_DoT(t, func([]byte) (n int, err error) { return })
+}
+
+func (FakeRW) _Write_StubAll(t testing.TB) {
+	t.Helper()
+	new(FakeRW)._Write_DoAll(t, func([]byte) (n int, err error) { return })
+}
+
+func (_recv *FakeRW) _Write_Return(n int, err error) {
+	_recv._Write_Do(func([]byte) (int, error) { return n, err })
+}
+
+func (_recv *FakeRW) _Write_ReturnT(t testing.TB, n int, err error) {
+	_recv._Write_DoT(t, func([]byte) (int, error) { return n, err })
+}
+
+func (FakeRW) _Write_ReturnAll(t testing.TB, n int, err error) {
+	t.Helper()
+	new(FakeRW)._Write_DoAll(t, func([]byte) (int, error) { return n, err })
+}
+
+func (_recv *FakeRW) _Write_Calls() []_FakeRW_Write_Call {
+	return mock.Calls(_FakeRWInstance(_recv, "FakeRW.Write"), (*_FakeRWData)._Write)
+}
+
+func (FakeRW) _Write_AllCalls() []_FakeRW_Write_Call {
+	return mock.Calls(_FakeRW.Global(), (*_FakeRWData)._Write)
+}
+
+func (FakeRW) _Write_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "FakeRW.Write")
+	mock.Bubble(t, _FakeRW.Global(), func(_dat *_FakeRWData) { _dat.Write.Calls.Clear() }, &_FakeRWPolicy.Write)
+}
+
+func (FakeRW) _Write_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "FakeRW.Write")
+	_FakeRWPolicy.Write.Record(t, limit)
+}
@@ -0,0 +1,152 @@
+// Code generated by lesiw.io/moxie. DO NOT EDIT.
+
+package testdata
+
+import (
+	"testing"
+
+	"lesiw.io/moxie/mock"
+
+	"lesiw.io/moxie/internal/testdata/pkg"
+)
+
+// FakeT1 embeds pkg.T1 so that its methods can be mocked.
+type FakeT1 struct {
+	pkg.T1
+}
+
+var (
+	_FakeT1       mock.Registry[FakeT1, _FakeT1Data]
+	_FakeT1Policy _FakeT1Policies
+)
+
+type _FakeT1Data struct {
+	Value mock.Method[func() pkg.Int, _FakeT1_Value_Call]
+}
+
+type _FakeT1Policies struct {
+	Value mock.Policy
+}
+
+func _FakeT1Entry(t *FakeT1) *mock.Entry[_FakeT1Data] {
+	return _FakeT1.Get(t)
+}
+
+func _FakeT1Instance(t *FakeT1, name string) *mock.Entry[_FakeT1Data] {
+	if t == nil {
+		panic(name + ": nil pointer receiver")
+	}
+	return _FakeT1Entry(t)
+}
+
+func (_recv *FakeT1) _FakeT1_Reset() {
+	_FakeT1Instance(_recv, "FakeT1").Reset()
+}
+
+func (FakeT1) _FakeT1_ResetAll() {
+	_FakeT1.Global().Reset()
+}
+
+func (FakeT1) _FakeT1_BubbleAll(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "FakeT1")
+	mock.Bubble(t, _FakeT1.Global(), (*_FakeT1Data).clearCalls, _FakeT1Policy.all()...)
+}
+
+func (FakeT1) _FakeT1_RecordAll(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "FakeT1")
+	for _, p := range _FakeT1Policy.all() {
+		p.Record(t, limit)
+	}
+}
+
+func (_dat *_FakeT1Data) clearCalls() {
+	_dat.Value.Calls.Clear()
+}
+
+func (_p *_FakeT1Policies) all() []*mock.Policy {
+	return []*mock.Policy{
+		&_p.Value,
+	}
+}
+
+type _FakeT1_Value_Call struct{}
+
+func (_dat *_FakeT1Data) _Value() *mock.Method[func() pkg.Int, _FakeT1_Value_Call] {
+	return &_dat.Value
+}
+
+func (_recv *FakeT1) Value() pkg.Int {
+	if _recv == nil {
+		panic("FakeT1.Value: nil pointer receiver")
+	}
+	if _FakeT1.Idle(&_FakeT1Policy.Value) {
+		return _recv.T1.Value()
+	}
+	_fn, _ok := mock.Next(_FakeT1Entry(_recv), _FakeT1.Global(), (*_FakeT1Data)._Value, &_FakeT1Policy.Value, _FakeT1_Value_Call{})
+	if !_ok {
+		_fn = _recv.T1.Value
+	}
+	return _fn()
+}
+
+func (_recv *FakeT1) _Value_Do(fn func() pkg.Int) {
+	mock.Do(_FakeT1Instance(_recv, "FakeT1.Value"), (*_FakeT1Data)._Value, fn)
+}
+
+func (_recv *FakeT1) _Value_DoT(t testing.TB, fn func() pkg.Int) {
+	mock.DoT(t, _FakeT1Instance(_recv, "FakeT1.Value"), (*_FakeT1Data)._Value, fn)
+}
+
+func (FakeT1) _Value_DoAll(t testing.TB, fn func() pkg.Int) {
+	t.Helper()
+	mock.Serial(t, "FakeT1.Value")
+	mock.DoAll(t, _FakeT1.Global(), (*_FakeT1Data)._Value, fn)
+}
+
+func (_recv *FakeT1) _Value_Stub() {
+	_recv._Value_Do(func() (r0 pkg.Int) { return })
+}
+
+func (_recv *FakeT1) _Value_StubT(t testing.TB) {
+	_recv._Value_DoT(t, func() (r0 pkg.Int) { return })
+}
+
+func (FakeT1) _Value_StubAll(t testing.TB) {
+	t.Helper()
+	new(FakeT1)._Value_DoAll(t, func() (r0 pkg.Int) { return })
+}
+
+func (_recv *FakeT1) _Value_Return(r0 pkg.Int) {
+	_recv._Value_Do(func() pkg.Int { return r0 })
+}
+
+func (_recv *FakeT1) _Value_ReturnT(t testing.TB, r0 pkg.Int) {
+	_recv._Value_DoT(t, func() pkg.Int { return r0 })
+}
+
+func (FakeT1) _Value_ReturnAll(t testing.TB, r0 pkg.Int) {
+	t.Helper()
+	new(FakeT1)._Value_DoAll(t, func() pkg.Int { return r0 })
+}
+
+func (_recv *FakeT1) _Value_Calls() []_FakeT1_Value_Call {
+	return mock.Calls(_FakeT1Instance(_recv, "FakeT1.Value"), (*_FakeT1Data)._Value)
+}
+
+func (FakeT1) _Value_AllCalls() []_FakeT1_Value_Call {
+	return mock.Calls(_FakeT1.Global(), (*_FakeT1Data)._Value)
+}
+
+func (FakeT1) _Value_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "FakeT1.Value")
+	mock.Bubble(t, _FakeT1.Global(), func(_dat *_FakeT1Data) { _dat.Value.Calls.Clear() }, &_FakeT1Policy.Value)
+}
+
+func (FakeT1) _Value_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "FakeT1.Value")
+	_FakeT1Policy.Value.Record(t, limit)
+}
@@ -0,0 +1,152 @@
+// Code generated by lesiw.io/moxie. DO NOT EDIT.
+
+package testdata
+
+import (
+	"testing"
+
+	"lesiw.io/moxie/mock"
+
+	"lesiw.io/moxie/internal/testdata/pkg"
+)
+
+// FakeT2 embeds pkg.T2 so that its methods can be mocked.
+type FakeT2 struct {
+	pkg.T2
+}
+
+var (
+	_FakeT2       mock.Registry[FakeT2, _FakeT2Data]
+	_FakeT2Policy _FakeT2Policies
+)
+
+type _FakeT2Data struct {
+	Visible mock.Method[func() pkg.Int, _FakeT2_Visible_Call]
+}
+
+type _FakeT2Policies struct {
+	Visible mock.Policy
+}
+
+func _FakeT2Entry(t *FakeT2) *mock.Entry[_FakeT2Data] {
+	return _FakeT2.Get(t)
+}
+
+func _FakeT2Instance(t *FakeT2, name string) *mock.Entry[_FakeT2Data] {
+	if t == nil {
+		panic(name + ": nil pointer receiver")
+	}
+	return _FakeT2Entry(t)
+}
+
+func (_recv *FakeT2) _FakeT2_Reset() {
+	_FakeT2Instance(_recv, "FakeT2").Reset()
+}
+
+func (FakeT2) _FakeT2_ResetAll() {
+	_FakeT2.Global().Reset()
+}
+
+func (FakeT2) _FakeT2_BubbleAll(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "FakeT2")
+	mock.Bubble(t, _FakeT2.Global(), (*_FakeT2Data).clearCalls, _FakeT2Policy.all()...)
+}
+
+func (FakeT2) _FakeT2_RecordAll(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "FakeT2")
+	for _, p := range _FakeT2Policy.all() {
+		p.Record(t, limit)
+	}
+}
+
+func (_dat *_FakeT2Data) clearCalls() {
+	_dat.Visible.Calls.Clear()
+}
+
+func (_p *_FakeT2Policies) all() []*mock.Policy {
+	return []*mock.Policy{
+		&_p.Visible,
+	}
+}
+
+type _FakeT2_Visible_Call struct{}
+
+func (_dat *_FakeT2Data) _Visible() *mock.Method[func() pkg.Int, _FakeT2_Visible_Call] {
+	return &_dat.Visible
+}
+
+func (_recv *FakeT2) Visible() pkg.Int {
+	if _recv == nil {
+		panic("FakeT2.Visible: nil pointer receiver")
+	}
+	if _FakeT2.Idle(&_FakeT2Policy.Visible) {
+		return _recv.T2.Visible()
+	}
+	_fn, _ok := mock.Next(_FakeT2Entry(_recv), _FakeT2.Global(), (*_FakeT2Data)._Visible, &_FakeT2Policy.Visible, _FakeT2_Visible_Call{})
+	if !_ok {
+		_fn = _recv.T2.Visible
+	}
+	return _fn()
+}
+
+func (_recv *FakeT2) _Visible_Do(fn func() pkg.Int) {
+	mock.Do(_FakeT2Instance(_recv, "FakeT2.Visible"), (*_FakeT2Data)._Visible, fn)
+}
+
+func (_recv *FakeT2) _Visible_DoT(t testing.TB, fn func() pkg.Int) {
+	mock.DoT(t, _FakeT2Instance(_recv, "FakeT2.Visible"), (*_FakeT2Data)._Visible, fn)
+}
+
+func (FakeT2) _Visible_DoAll(t testing.TB, fn func() pkg.Int) {
+	t.Helper()
+	mock.Serial(t, "FakeT2.Visible")
+	mock.DoAll(t, _FakeT2.Global(), (*_FakeT2Data)._Visible, fn)
+}
+
+func (_recv *FakeT2) _Visible_Stub() {
+	_recv._Visible_Do(func() (r0 pkg.Int) { return })
+}
+
+func (_recv *FakeT2) _Visible_StubT(t testing.TB) {
+	_recv._Visible_DoT(t, func() (r0 pkg.Int) { return })
+}
+
+func (FakeT2) _Visible_StubAll(t testing.TB) {
+	t.Helper()
+	new(FakeT2)._Visible_DoAll(t, func() (r0 pkg.Int) { return })
+}
+
+func (_recv *FakeT2) _Visible_Return(r0 pkg.Int) {
+	_recv._Visible_Do(func() pkg.Int { return r0 })
+}
+
+func (_recv *FakeT2) _Visible_ReturnT(t testing.TB, r0 pkg.Int) {
+	_recv._Visible_DoT(t, func() pkg.Int { return r0 })
+}
+
+func (FakeT2) _Visible_ReturnAll(t testing.TB, r0 pkg.Int) {
+	t.Helper()
+	new(FakeT2)._Visible_DoAll(t, func() pkg.Int { return r0 })
+}
+
+func (_recv *FakeT2) _Visible_Calls() []_FakeT2_Visible_Call {
+	return mock.Calls(_FakeT2Instance(_recv, "FakeT2.Visible"), (*_FakeT2Data)._Visible)
+}
+
+func (FakeT2) _Visible_AllCalls() []_FakeT2_Visible_Call {
+	return mock.Calls(_FakeT2.Global(), (*_FakeT2Data)._Visible)
+}
+
+func (FakeT2) _Visible_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "FakeT2.Visible")
+	mock.Bubble(t, _FakeT2.Global(), func(_dat *_FakeT2Data) { _dat.Visible.Calls.Clear() }, &_FakeT2Policy.Visible)
+}
+
+func (FakeT2) _Visible_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "FakeT2.Visible")
+	_FakeT2Policy.Visible.Record(t, limit)
+}
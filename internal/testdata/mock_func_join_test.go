@@ -0,0 +1,51 @@
+// Code generated by lesiw.io/moxie. DO NOT EDIT.
+
+package testdata
+
+import (
+	"testing"
+
+	"lesiw.io/moxie/mock"
+)
+
+var _join mock.Func[func(sep string, elems ...string) string, _join_Call]
+
+type _join_Call struct {
+	Sep   string
+	Elems []string
+}
+
+func _join_proxy(_orig func(sep string, elems ...string) string) func(sep string, elems ...string) string {
+	return func(sep string, elems ...string) string {
+		_fn, _ok := _join.Next(_join_Call{sep, elems})
+		if !_ok {
+			_fn = _orig
+		}
+		return _fn(sep, elems...)
+	}
+}
+
+func _join_Do(t testing.TB, fn func(string, ...string) string) {
+	t.Helper()
+	mock.Serial(t, "join")
+	_join.Do(t, &join, _join_proxy, fn)
+}
+
+func _join_DoAll(t testing.TB, fn func(string, ...string) string) {
+	t.Helper()
+	_join_Do(t, fn)
+}
+
+func _join_Stub(t testing.TB) {
+	t.Helper()
+	_join_Do(t, func(string, ...string) (r0 string) { return })
+}
+
+func _join_Return(t testing.TB, r0 string) {
+	t.Helper()
+	_join_Do(t, func(string, ...string) string { return r0 })
+}
+
+func _join_Calls() []_join_Call {
+	return _join.Calls()
+}
@@ -0,0 +1,58 @@
+// Code generated by lesiw.io/moxie. DO NOT EDIT.
+
+package testdata
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"lesiw.io/moxie/mock"
+)
+
+var _now mock.Func[func() time.Time, _now_Call]
+
+type _now_Call struct{}
+
+func _now_proxy(_orig func() time.Time) func() time.Time {
+	return func() time.Time {
+		_fn, _ok := _now.Next(_now_Call{})
+		if !_ok {
+			_fn = _orig
+		}
+		return _fn()
+	}
+}
+
+func _now_Do(t testing.TB, fn func() time.Time) {
+	t.Helper()
+	mock.Serial(t, "now")
+	_now.Do(t, &now, _now_proxy, fn)
+}
+
+func _now_DoAll(t testing.TB, fn func() time.Time) {
+	t.Helper()
+	_now_Do(t, fn)
+}
+
+func _now_Stub(t testing.TB) {
+	t.Helper()
+	_now_Do(t, func() (r0 time.Time) { return })
+}
+
+func _now_Return(t testing.TB, r0 time.Time) {
+	t.Helper()
+	_now_Do(t, func() time.Time { return r0 })
+}
+
+func _now_Calls() []_now_Call {
+	return _now.Calls()
+}
+
+func _now_AssertCalls(t testing.TB, want int) {
+	t.Helper()
+	if got := len(_now_Calls()); got != want {
+		t.Errorf("now: want %s calls, got %d",
+			strconv.Itoa(want), got)
+	}
+}
@@ -0,0 +1,45 @@
+// Code generated by lesiw.io/moxie. DO NOT EDIT.
+
+package testdata
+
+import (
+	"context"
+
+	"lesiw.io/moxie/mock"
+)
+
+type _HandlerMock struct {
+	_cb mock.Callback[Handler, _Handler_Call]
+}
+
+type _Handler_Call struct {
+	Ctx context.Context
+	Req string
+}
+
+func _Handler_New() (Handler, *_HandlerMock) {
+	_m := new(_HandlerMock)
+	return func(ctx context.Context, req string) (string, error) {
+		_fn, _ok := _m._cb.Next(_Handler_Call{ctx, req})
+		if !_ok {
+			panic("Handler: not mocked")
+		}
+		return _fn(ctx, req)
+	}, _m
+}
+
+func (_m *_HandlerMock) _Do(fn func(context.Context, string) (string, error)) {
+	_m._cb.Do(fn)
+}
+
+func (_m *_HandlerMock) _Stub() {
+	_m._Do(func(context.Context, string) (r0 string, r1 error) { return })
+}
+
+func (_m *_HandlerMock) _Return(r0 string, r1 error) {
+	_m._Do(func(context.Context, string) (string, error) { return r0, r1 })
+}
+
+func (_m *_HandlerMock) _Calls() []_Handler_Call {
+	return _m._cb.Calls()
+}
@@ -3,89 +3,196 @@
 package testdata
 
 import (
-	"runtime"
-	"sync"
+	"context"
 	"testing"
-	"unsafe"
 
-	pkg "lesiw.io/moxie/internal/testdata/pkg"
+	"lesiw.io/moxie/mock"
+
+	"lesiw.io/moxie/internal/testdata/pkg"
 )
 
-var _M0 = new(sync.Map)
+var (
+	_M0       mock.Registry[M0, _M0Data]
+	_M0Policy _M0Policies
+)
 
 type _M0Data struct {
-	mutex                     sync.Mutex
-	once                      sync.Once
-	AllNamedIdentifiersMocks  []func(x pkg.String, y ...pkg.String) (n pkg.Int, err error)
-	AllNamedIdentifiersCalls  []_M0_AllNamedIdentifiers_Call
-	MixedNoResultMocks        []func(pkg.String, ...pkg.String)
-	MixedNoResultCalls        []_M0_MixedNoResult_Call
-	MixedOneResultMocks       []func(pkg.String, ...pkg.String) error
-	MixedOneResultCalls       []_M0_MixedOneResult_Call
-	MixedTwoResultsMocks      []func(pkg.String, ...pkg.String) (pkg.Int, error)
-	MixedTwoResultsCalls      []_M0_MixedTwoResults_Call
-	NamedMixedNoResultMocks   []func(x pkg.String, y ...pkg.String)
-	NamedMixedNoResultCalls   []_M0_NamedMixedNoResult_Call
-	NamedMixedOneResultMocks  []func(x pkg.String, y ...pkg.String) error
-	NamedMixedOneResultCalls  []_M0_NamedMixedOneResult_Call
-	NamedMixedTwoResultsMocks []func(x pkg.String, y ...pkg.String) (pkg.Int, error)
-	NamedMixedTwoResultsCalls []_M0_NamedMixedTwoResults_Call
-	NamedParamNoResultMocks   []func(x pkg.String)
-	NamedParamNoResultCalls   []_M0_NamedParamNoResult_Call
-	NamedParamOneResultMocks  []func(x pkg.String) error
-	NamedParamOneResultCalls  []_M0_NamedParamOneResult_Call
-	NamedParamTwoResultsMocks []func(x pkg.String) (pkg.Int, error)
-	NamedParamTwoResultsCalls []_M0_NamedParamTwoResults_Call
-	OneNamedResultMocks       []func() (err error)
-	OneNamedResultCalls       []_M0_OneNamedResult_Call
-	OneParamNoResultMocks     []func(pkg.String)
-	OneParamNoResultCalls     []_M0_OneParamNoResult_Call
-	OneParamOneResultMocks    []func(pkg.String) error
-	OneParamOneResultCalls    []_M0_OneParamOneResult_Call
-	OneParamTwoResultsMocks   []func(pkg.String) (pkg.Int, error)
-	OneParamTwoResultsCalls   []_M0_OneParamTwoResults_Call
-	OneResultMocks            []func() error
-	OneResultCalls            []_M0_OneResult_Call
-	ReadMocks                 []func(p []byte) (n int, err error)
-	ReadCalls                 []_M0_Read_Call
-	SimpleMocks               []func()
-	SimpleCalls               []_M0_Simple_Call
-	TwoNamedResultsMocks      []func() (n pkg.Int, err error)
-	TwoNamedResultsCalls      []_M0_TwoNamedResults_Call
-	TwoParamsNoResultMocks    []func(pkg.String, pkg.String)
-	TwoParamsNoResultCalls    []_M0_TwoParamsNoResult_Call
-	TwoParamsOneResultMocks   []func(pkg.String, pkg.String) error
-	TwoParamsOneResultCalls   []_M0_TwoParamsOneResult_Call
-	TwoParamsTwoResultsMocks  []func(pkg.String, pkg.String) (pkg.Int, error)
-	TwoParamsTwoResultsCalls  []_M0_TwoParamsTwoResults_Call
-	TwoResultsMocks           []func() (pkg.Int, error)
-	TwoResultsCalls           []_M0_TwoResults_Call
-	VariadicNoResultMocks     []func(...pkg.String)
-	VariadicNoResultCalls     []_M0_VariadicNoResult_Call
-	VariadicOneResultMocks    []func(...pkg.String) error
-	VariadicOneResultCalls    []_M0_VariadicOneResult_Call
-	VariadicTwoResultsMocks   []func(...pkg.String) (pkg.Int, error)
-	VariadicTwoResultsCalls   []_M0_VariadicTwoResults_Call
-	WriteMocks                []func(p []byte) (n int, err error)
-	WriteCalls                []_M0_Write_Call
-}
-
-func _M0PtrData(t *M0) *_M0Data {
-	var ptr uintptr
-	if t != nil {
-		ptr = uintptr(unsafe.Pointer(t))
-	}
-	val, loaded := _M0.LoadOrStore(ptr, new(_M0Data))
-	if !loaded && t != nil {
-		val.(*_M0Data).once.Do(func() { runtime.SetFinalizer(t, func(_ *M0) { _M0.Delete(ptr) }) })
-	}
-	return val.(*_M0Data)
+	AllNamedIdentifiers  mock.Method[func(pkg.String, ...pkg.String) (pkg.Int, error), _M0_AllNamedIdentifiers_Call]
+	ContextNoResult      mock.Method[func(context.Context), _M0_ContextNoResult_Call]
+	ContextOneResult     mock.Method[func(context.Context, pkg.String) error, _M0_ContextOneResult_Call]
+	ContextTwoResults    mock.Method[func(context.Context) (pkg.Int, error), _M0_ContextTwoResults_Call]
+	MixedNoResult        mock.Method[func(pkg.String, ...pkg.String), _M0_MixedNoResult_Call]
+	MixedOneResult       mock.Method[func(pkg.String, ...pkg.String) error, _M0_MixedOneResult_Call]
+	MixedTwoResults      mock.Method[func(pkg.String, ...pkg.String) (pkg.Int, error), _M0_MixedTwoResults_Call]
+	NamedMixedNoResult   mock.Method[func(pkg.String, ...pkg.String), _M0_NamedMixedNoResult_Call]
+	NamedMixedOneResult  mock.Method[func(pkg.String, ...pkg.String) error, _M0_NamedMixedOneResult_Call]
+	NamedMixedTwoResults mock.Method[func(pkg.String, ...pkg.String) (pkg.Int, error), _M0_NamedMixedTwoResults_Call]
+	NamedParamNoResult   mock.Method[func(pkg.String), _M0_NamedParamNoResult_Call]
+	NamedParamOneResult  mock.Method[func(pkg.String) error, _M0_NamedParamOneResult_Call]
+	NamedParamTwoResults mock.Method[func(pkg.String) (pkg.Int, error), _M0_NamedParamTwoResults_Call]
+	OneNamedResult       mock.Method[func() error, _M0_OneNamedResult_Call]
+	OneParamNoResult     mock.Method[func(pkg.String), _M0_OneParamNoResult_Call]
+	OneParamOneResult    mock.Method[func(pkg.String) error, _M0_OneParamOneResult_Call]
+	OneParamTwoResults   mock.Method[func(pkg.String) (pkg.Int, error), _M0_OneParamTwoResults_Call]
+	OneResult            mock.Method[func() error, _M0_OneResult_Call]
+	Read                 mock.Method[func([]byte) (int, error), _M0_Read_Call]
+	Simple               mock.Method[func(), _M0_Simple_Call]
+	TwoNamedResults      mock.Method[func() (pkg.Int, error), _M0_TwoNamedResults_Call]
+	TwoParamsNoResult    mock.Method[func(pkg.String, pkg.String), _M0_TwoParamsNoResult_Call]
+	TwoParamsOneResult   mock.Method[func(pkg.String, pkg.String) error, _M0_TwoParamsOneResult_Call]
+	TwoParamsTwoResults  mock.Method[func(pkg.String, pkg.String) (pkg.Int, error), _M0_TwoParamsTwoResults_Call]
+	TwoResults           mock.Method[func() (pkg.Int, error), _M0_TwoResults_Call]
+	VariadicNoResult     mock.Method[func(...pkg.String), _M0_VariadicNoResult_Call]
+	VariadicOneResult    mock.Method[func(...pkg.String) error, _M0_VariadicOneResult_Call]
+	VariadicTwoResults   mock.Method[func(...pkg.String) (pkg.Int, error), _M0_VariadicTwoResults_Call]
+	Write                mock.Method[func([]byte) (int, error), _M0_Write_Call]
+}
+
+type _M0Policies struct {
+	AllNamedIdentifiers  mock.Policy
+	ContextNoResult      mock.Policy
+	ContextOneResult     mock.Policy
+	ContextTwoResults    mock.Policy
+	MixedNoResult        mock.Policy
+	MixedOneResult       mock.Policy
+	MixedTwoResults      mock.Policy
+	NamedMixedNoResult   mock.Policy
+	NamedMixedOneResult  mock.Policy
+	NamedMixedTwoResults mock.Policy
+	NamedParamNoResult   mock.Policy
+	NamedParamOneResult  mock.Policy
+	NamedParamTwoResults mock.Policy
+	OneNamedResult       mock.Policy
+	OneParamNoResult     mock.Policy
+	OneParamOneResult    mock.Policy
+	OneParamTwoResults   mock.Policy
+	OneResult            mock.Policy
+	Read                 mock.Policy
+	Simple               mock.Policy
+	TwoNamedResults      mock.Policy
+	TwoParamsNoResult    mock.Policy
+	TwoParamsOneResult   mock.Policy
+	TwoParamsTwoResults  mock.Policy
+	TwoResults           mock.Policy
+	VariadicNoResult     mock.Policy
+	VariadicOneResult    mock.Policy
+	VariadicTwoResults   mock.Policy
+	Write                mock.Policy
+}
+
+func _M0Entry(t *M0) *mock.Entry[_M0Data] {
+	return _M0.Get(t)
+}
+
+func _M0Instance(t *M0, name string) *mock.Entry[_M0Data] {
+	if t == nil {
+		panic(name + ": nil pointer receiver")
+	}
+	return _M0Entry(t)
+}
+
+func (_recv *M0) _M0_Reset() {
+	_M0Instance(_recv, "M0").Reset()
+}
+
+func (M0) _M0_ResetAll() {
+	_M0.Global().Reset()
+}
+
+func (M0) _M0_BubbleAll(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M0")
+	mock.Bubble(t, _M0.Global(), (*_M0Data).clearCalls, _M0Policy.all()...)
+}
+
+func (M0) _M0_RecordAll(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M0")
+	for _, p := range _M0Policy.all() {
+		p.Record(t, limit)
+	}
+}
+
+func (_dat *_M0Data) clearCalls() {
+	_dat.AllNamedIdentifiers.Calls.Clear()
+	_dat.ContextNoResult.Calls.Clear()
+	_dat.ContextOneResult.Calls.Clear()
+	_dat.ContextTwoResults.Calls.Clear()
+	_dat.MixedNoResult.Calls.Clear()
+	_dat.MixedOneResult.Calls.Clear()
+	_dat.MixedTwoResults.Calls.Clear()
+	_dat.NamedMixedNoResult.Calls.Clear()
+	_dat.NamedMixedOneResult.Calls.Clear()
+	_dat.NamedMixedTwoResults.Calls.Clear()
+	_dat.NamedParamNoResult.Calls.Clear()
+	_dat.NamedParamOneResult.Calls.Clear()
+	_dat.NamedParamTwoResults.Calls.Clear()
+	_dat.OneNamedResult.Calls.Clear()
+	_dat.OneParamNoResult.Calls.Clear()
+	_dat.OneParamOneResult.Calls.Clear()
+	_dat.OneParamTwoResults.Calls.Clear()
+	_dat.OneResult.Calls.Clear()
+	_dat.Read.Calls.Clear()
+	_dat.Simple.Calls.Clear()
+	_dat.TwoNamedResults.Calls.Clear()
+	_dat.TwoParamsNoResult.Calls.Clear()
+	_dat.TwoParamsOneResult.Calls.Clear()
+	_dat.TwoParamsTwoResults.Calls.Clear()
+	_dat.TwoResults.Calls.Clear()
+	_dat.VariadicNoResult.Calls.Clear()
+	_dat.VariadicOneResult.Calls.Clear()
+	_dat.VariadicTwoResults.Calls.Clear()
+	_dat.Write.Calls.Clear()
+}
+
+func (_p *_M0Policies) all() []*mock.Policy {
+	return []*mock.Policy{
+		&_p.AllNamedIdentifiers,
+		&_p.ContextNoResult,
+		&_p.ContextOneResult,
+		&_p.ContextTwoResults,
+		&_p.MixedNoResult,
+		&_p.MixedOneResult,
+		&_p.MixedTwoResults,
+		&_p.NamedMixedNoResult,
+		&_p.NamedMixedOneResult,
+		&_p.NamedMixedTwoResults,
+		&_p.NamedParamNoResult,
+		&_p.NamedParamOneResult,
+		&_p.NamedParamTwoResults,
+		&_p.OneNamedResult,
+		&_p.OneParamNoResult,
+		&_p.OneParamOneResult,
+		&_p.OneParamTwoResults,
+		&_p.OneResult,
+		&_p.Read,
+		&_p.Simple,
+		&_p.TwoNamedResults,
+		&_p.TwoParamsNoResult,
+		&_p.TwoParamsOneResult,
+		&_p.TwoParamsTwoResults,
+		&_p.TwoResults,
+		&_p.VariadicNoResult,
+		&_p.VariadicOneResult,
+		&_p.VariadicTwoResults,
+		&_p.Write,
+	}
 }
 
 type _M0_AllNamedIdentifiers_Call struct {
 	X pkg.String
 	Y []pkg.String
 }
+type _M0_ContextNoResult_Call struct {
+	P0 context.Context
+}
+type _M0_ContextOneResult_Call struct {
+	Ctx context.Context
+	X   pkg.String
+}
+type _M0_ContextTwoResults_Call struct {
+	Ctx context.Context
+}
 type _M0_MixedNoResult_Call struct {
 	P0 pkg.String
 	P1 []pkg.String
@@ -161,81 +268,48 @@ type _M0_Write_Call struct {
 	P []byte
 }
 
+func (_dat *_M0Data) _AllNamedIdentifiers() *mock.Method[func(pkg.String, ...pkg.String) (pkg.Int, error), _M0_AllNamedIdentifiers_Call] {
+	return &_dat.AllNamedIdentifiers
+}
+
 func (_recv *M0) AllNamedIdentifiers(x pkg.String, y ...pkg.String) (pkg.Int, error) {
 	if _recv == nil {
 		panic("M0.AllNamedIdentifiers: nil pointer receiver")
 	}
-	_dat := _M0PtrData(_recv)
-	_dat.mutex.Lock()
-	_dat.AllNamedIdentifiersCalls = append(_dat.AllNamedIdentifiersCalls, _M0_AllNamedIdentifiers_Call{x, y})
-	_all := _M0PtrData(nil)
-	_all.mutex.Lock()
-	_all.AllNamedIdentifiersCalls = append(_all.AllNamedIdentifiersCalls, _M0_AllNamedIdentifiers_Call{x, y})
-	var _fn func(pkg.String, ...pkg.String) (pkg.Int, error)
-	if len(_dat.AllNamedIdentifiersMocks) > 0 {
-		_fn = _dat.AllNamedIdentifiersMocks[0]
-		if len(_dat.AllNamedIdentifiersMocks) > 1 {
-			_dat.AllNamedIdentifiersMocks = _dat.AllNamedIdentifiersMocks[1:]
-		}
-	} else if len(_all.AllNamedIdentifiersMocks) > 0 {
-		_fn = _all.AllNamedIdentifiersMocks[0]
-		if len(_all.AllNamedIdentifiersMocks) > 1 {
-			_all.AllNamedIdentifiersMocks = _all.AllNamedIdentifiersMocks[1:]
-		}
-	} else {
+	if _M0.Idle(&_M0Policy.AllNamedIdentifiers) {
+		return _recv.T0.AllNamedIdentifiers(x, y...)
+	}
+	_fn, _ok := mock.Next(_M0Entry(_recv), _M0.Global(), (*_M0Data)._AllNamedIdentifiers, &_M0Policy.AllNamedIdentifiers, _M0_AllNamedIdentifiers_Call{x, y})
+	if !_ok {
 		_fn = _recv.T0.AllNamedIdentifiers
 	}
-	_dat.mutex.Unlock()
-	_all.mutex.Unlock()
 	return _fn(x, y...)
 }
 
 func (_recv *M0) _AllNamedIdentifiers_Do(fn func(pkg.String, ...pkg.String) (pkg.Int, error)) {
-	if _recv == nil {
-		panic("M0.AllNamedIdentifiers: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.AllNamedIdentifiersMocks = []func(pkg.String, ...pkg.String) (pkg.Int, error){}
-	} else if len(_dat.AllNamedIdentifiersMocks) < 2 {
-		_dat.AllNamedIdentifiersMocks = []func(pkg.String, ...pkg.String) (pkg.Int, error){fn, fn}
-	} else {
-		_dat.AllNamedIdentifiersMocks = _dat.AllNamedIdentifiersMocks[:len(_dat.AllNamedIdentifiersMocks)-1]
-		_dat.AllNamedIdentifiersMocks = append(_dat.AllNamedIdentifiersMocks, fn)
-		_dat.AllNamedIdentifiersMocks = append(_dat.AllNamedIdentifiersMocks, fn)
-	}
-}
-
-func (M0) _AllNamedIdentifiers_DoAll(t *testing.T, fn func(pkg.String, ...pkg.String) (pkg.Int, error)) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.AllNamedIdentifiersMocks = []func(pkg.String, ...pkg.String) (pkg.Int, error){}
-	} else if len(_dat.AllNamedIdentifiersMocks) < 2 {
-		_dat.AllNamedIdentifiersMocks = []func(pkg.String, ...pkg.String) (pkg.Int, error){fn, fn}
-	} else {
-		_dat.AllNamedIdentifiersMocks = _dat.AllNamedIdentifiersMocks[:len(_dat.AllNamedIdentifiersMocks)-1]
-		_dat.AllNamedIdentifiersMocks = append(_dat.AllNamedIdentifiersMocks, fn)
-		_dat.AllNamedIdentifiersMocks = append(_dat.AllNamedIdentifiersMocks, fn)
-	}
-	_dat.once.Do(func() {
-		t.Cleanup(func() {
-			defer _dat.mutex.Unlock()
-			_dat.mutex.Lock()
-			_dat.AllNamedIdentifiersMocks = []func(pkg.String, ...pkg.String) (pkg.Int, error){}
-			_dat.once = sync.Once{}
-		})
-	})
+	mock.Do(_M0Instance(_recv, "M0.AllNamedIdentifiers"), (*_M0Data)._AllNamedIdentifiers, fn)
+}
+
+func (_recv *M0) _AllNamedIdentifiers_DoT(t testing.TB, fn func(pkg.String, ...pkg.String) (pkg.Int, error)) {
+	mock.DoT(t, _M0Instance(_recv, "M0.AllNamedIdentifiers"), (*_M0Data)._AllNamedIdentifiers, fn)
+}
+
+func (M0) _AllNamedIdentifiers_DoAll(t testing.TB, fn func(pkg.String, ...pkg.String) (pkg.Int, error)) {
+	t.Helper()
+	mock.Serial(t, "M0.AllNamedIdentifiers")
+	mock.DoAll(t, _M0.Global(), (*_M0Data)._AllNamedIdentifiers, fn)
 }
 
 func (_recv *M0) _AllNamedIdentifiers_Stub() {
 	_recv._AllNamedIdentifiers_Do(func(pkg.String, ...pkg.String) (n pkg.Int, err error) { return })
 }
 
-func (M0) _AllNamedIdentifiers_StubAll(t *testing.T) {
+func (_recv *M0) _AllNamedIdentifiers_StubT(t testing.TB) {
+	_recv._AllNamedIdentifiers_DoT(t, func(pkg.String, ...pkg.String) (n pkg.Int, err error) { return })
+}
+
+func (M0) _AllNamedIdentifiers_StubAll(t testing.TB) {
+	t.Helper()
 	new(M0)._AllNamedIdentifiers_DoAll(t, func(pkg.String, ...pkg.String) (n pkg.Int, err error) { return })
 }
 
@@ -243,114 +317,316 @@ func (_recv *M0) _AllNamedIdentifiers_Return(n pkg.Int, err error) {
 	_recv._AllNamedIdentifiers_Do(func(pkg.String, ...pkg.String) (pkg.Int, error) { return n, err })
 }
 
-func (M0) _AllNamedIdentifiers_ReturnAll(t *testing.T, n pkg.Int, err error) {
+func (_recv *M0) _AllNamedIdentifiers_ReturnT(t testing.TB, n pkg.Int, err error) {
+	_recv._AllNamedIdentifiers_DoT(t, func(pkg.String, ...pkg.String) (pkg.Int, error) { return n, err })
+}
+
+func (M0) _AllNamedIdentifiers_ReturnAll(t testing.TB, n pkg.Int, err error) {
+	t.Helper()
 	new(M0)._AllNamedIdentifiers_DoAll(t, func(pkg.String, ...pkg.String) (pkg.Int, error) { return n, err })
 }
 
 func (_recv *M0) _AllNamedIdentifiers_Calls() []_M0_AllNamedIdentifiers_Call {
+	return mock.Calls(_M0Instance(_recv, "M0.AllNamedIdentifiers"), (*_M0Data)._AllNamedIdentifiers)
+}
+
+func (M0) _AllNamedIdentifiers_AllCalls() []_M0_AllNamedIdentifiers_Call {
+	return mock.Calls(_M0.Global(), (*_M0Data)._AllNamedIdentifiers)
+}
+
+func (M0) _AllNamedIdentifiers_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M0.AllNamedIdentifiers")
+	mock.Bubble(t, _M0.Global(), func(_dat *_M0Data) { _dat.AllNamedIdentifiers.Calls.Clear() }, &_M0Policy.AllNamedIdentifiers)
+}
+
+func (M0) _AllNamedIdentifiers_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M0.AllNamedIdentifiers")
+	_M0Policy.AllNamedIdentifiers.Record(t, limit)
+}
+
+func (_dat *_M0Data) _ContextNoResult() *mock.Method[func(context.Context), _M0_ContextNoResult_Call] {
+	return &_dat.ContextNoResult
+}
+
+func (_recv *M0) ContextNoResult(P0 context.Context) {
 	if _recv == nil {
-		panic("M0.AllNamedIdentifiers: nil pointer receiver")
+		panic("M0.ContextNoResult: nil pointer receiver")
 	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.AllNamedIdentifiersCalls
+	if _M0.Idle(&_M0Policy.ContextNoResult) &&
+		!mock.InContext(P0, _M0_ContextNoResult_CtxKey{}) {
+		_recv.T0.ContextNoResult(P0)
+		return
+	}
+	_fn, _ok := mock.NextContext(P0, _M0_ContextNoResult_CtxKey{}, _M0Entry(_recv), _M0.Global(), (*_M0Data)._ContextNoResult, &_M0Policy.ContextNoResult, _M0_ContextNoResult_Call{P0})
+	if !_ok {
+		_fn = _recv.T0.ContextNoResult
+	}
+	_fn(P0)
 }
 
-func (M0) _AllNamedIdentifiers_AllCalls() []_M0_AllNamedIdentifiers_Call {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.AllNamedIdentifiersCalls
+func (_recv *M0) _ContextNoResult_Do(fn func(context.Context)) {
+	mock.Do(_M0Instance(_recv, "M0.ContextNoResult"), (*_M0Data)._ContextNoResult, fn)
+}
+
+func (_recv *M0) _ContextNoResult_DoT(t testing.TB, fn func(context.Context)) {
+	mock.DoT(t, _M0Instance(_recv, "M0.ContextNoResult"), (*_M0Data)._ContextNoResult, fn)
+}
+
+func (M0) _ContextNoResult_DoAll(t testing.TB, fn func(context.Context)) {
+	t.Helper()
+	mock.Serial(t, "M0.ContextNoResult")
+	mock.DoAll(t, _M0.Global(), (*_M0Data)._ContextNoResult, fn)
+}
+
+func (_recv *M0) _ContextNoResult_Stub() {
+	_recv._ContextNoResult_Do(func(context.Context) { return })
+}
+
+func (_recv *M0) _ContextNoResult_StubT(t testing.TB) {
+	_recv._ContextNoResult_DoT(t, func(context.Context) { return })
+}
+
+func (M0) _ContextNoResult_StubAll(t testing.TB) {
+	t.Helper()
+	new(M0)._ContextNoResult_DoAll(t, func(context.Context) { return })
+}
+
+func (_recv *M0) _ContextNoResult_Return() {
+	_recv._ContextNoResult_Do(func(context.Context) { return })
+}
+
+func (_recv *M0) _ContextNoResult_ReturnT(t testing.TB) {
+	_recv._ContextNoResult_DoT(t, func(context.Context) { return })
+}
+
+func (M0) _ContextNoResult_ReturnAll(t testing.TB) {
+	t.Helper()
+	new(M0)._ContextNoResult_DoAll(t, func(context.Context) { return })
+}
+
+func (_recv *M0) _ContextNoResult_Calls() []_M0_ContextNoResult_Call {
+	return mock.Calls(_M0Instance(_recv, "M0.ContextNoResult"), (*_M0Data)._ContextNoResult)
+}
+
+func (M0) _ContextNoResult_AllCalls() []_M0_ContextNoResult_Call {
+	return mock.Calls(_M0.Global(), (*_M0Data)._ContextNoResult)
+}
+
+func (M0) _ContextNoResult_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M0.ContextNoResult")
+	mock.Bubble(t, _M0.Global(), func(_dat *_M0Data) { _dat.ContextNoResult.Calls.Clear() }, &_M0Policy.ContextNoResult)
+}
+
+func (M0) _ContextNoResult_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M0.ContextNoResult")
+	_M0Policy.ContextNoResult.Record(t, limit)
+}
+
+func (_dat *_M0Data) _ContextOneResult() *mock.Method[func(context.Context, pkg.String) error, _M0_ContextOneResult_Call] {
+	return &_dat.ContextOneResult
+}
+
+func (_recv *M0) ContextOneResult(ctx context.Context, x pkg.String) error {
+	if _recv == nil {
+		panic("M0.ContextOneResult: nil pointer receiver")
+	}
+	if _M0.Idle(&_M0Policy.ContextOneResult) &&
+		!mock.InContext(ctx, _M0_ContextOneResult_CtxKey{}) {
+		return _recv.T0.ContextOneResult(ctx, x)
+	}
+	_fn, _ok := mock.NextContext(ctx, _M0_ContextOneResult_CtxKey{}, _M0Entry(_recv), _M0.Global(), (*_M0Data)._ContextOneResult, &_M0Policy.ContextOneResult, _M0_ContextOneResult_Call{ctx, x})
+	if !_ok {
+		_fn = _recv.T0.ContextOneResult
+	}
+	return _fn(ctx, x)
+}
+
+func (_recv *M0) _ContextOneResult_Do(fn func(context.Context, pkg.String) error) {
+	mock.Do(_M0Instance(_recv, "M0.ContextOneResult"), (*_M0Data)._ContextOneResult, fn)
+}
+
+func (_recv *M0) _ContextOneResult_DoT(t testing.TB, fn func(context.Context, pkg.String) error) {
+	mock.DoT(t, _M0Instance(_recv, "M0.ContextOneResult"), (*_M0Data)._ContextOneResult, fn)
+}
+
+func (M0) _ContextOneResult_DoAll(t testing.TB, fn func(context.Context, pkg.String) error) {
+	t.Helper()
+	mock.Serial(t, "M0.ContextOneResult")
+	mock.DoAll(t, _M0.Global(), (*_M0Data)._ContextOneResult, fn)
+}
+
+func (_recv *M0) _ContextOneResult_Stub() {
+	_recv._ContextOneResult_Do(func(context.Context, pkg.String) (r0 error) { return })
+}
+
+func (_recv *M0) _ContextOneResult_StubT(t testing.TB) {
+	_recv._ContextOneResult_DoT(t, func(context.Context, pkg.String) (r0 error) { return })
+}
+
+func (M0) _ContextOneResult_StubAll(t testing.TB) {
+	t.Helper()
+	new(M0)._ContextOneResult_DoAll(t, func(context.Context, pkg.String) (r0 error) { return })
+}
+
+func (_recv *M0) _ContextOneResult_Return(r0 error) {
+	_recv._ContextOneResult_Do(func(context.Context, pkg.String) error { return r0 })
+}
+
+func (_recv *M0) _ContextOneResult_ReturnT(t testing.TB, r0 error) {
+	_recv._ContextOneResult_DoT(t, func(context.Context, pkg.String) error { return r0 })
+}
+
+func (M0) _ContextOneResult_ReturnAll(t testing.TB, r0 error) {
+	t.Helper()
+	new(M0)._ContextOneResult_DoAll(t, func(context.Context, pkg.String) error { return r0 })
+}
+
+func (_recv *M0) _ContextOneResult_Calls() []_M0_ContextOneResult_Call {
+	return mock.Calls(_M0Instance(_recv, "M0.ContextOneResult"), (*_M0Data)._ContextOneResult)
+}
+
+func (M0) _ContextOneResult_AllCalls() []_M0_ContextOneResult_Call {
+	return mock.Calls(_M0.Global(), (*_M0Data)._ContextOneResult)
+}
+
+func (M0) _ContextOneResult_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M0.ContextOneResult")
+	mock.Bubble(t, _M0.Global(), func(_dat *_M0Data) { _dat.ContextOneResult.Calls.Clear() }, &_M0Policy.ContextOneResult)
+}
+
+func (M0) _ContextOneResult_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M0.ContextOneResult")
+	_M0Policy.ContextOneResult.Record(t, limit)
+}
+
+func (_dat *_M0Data) _ContextTwoResults() *mock.Method[func(context.Context) (pkg.Int, error), _M0_ContextTwoResults_Call] {
+	return &_dat.ContextTwoResults
+}
+
+func (_recv *M0) ContextTwoResults(ctx context.Context) (pkg.Int, error) {
+	if _recv == nil {
+		panic("M0.ContextTwoResults: nil pointer receiver")
+	}
+	if _M0.Idle(&_M0Policy.ContextTwoResults) &&
+		!mock.InContext(ctx, _M0_ContextTwoResults_CtxKey{}) {
+		return _recv.T0.ContextTwoResults(ctx)
+	}
+	_fn, _ok := mock.NextContext(ctx, _M0_ContextTwoResults_CtxKey{}, _M0Entry(_recv), _M0.Global(), (*_M0Data)._ContextTwoResults, &_M0Policy.ContextTwoResults, _M0_ContextTwoResults_Call{ctx})
+	if !_ok {
+		_fn = _recv.T0.ContextTwoResults
+	}
+	return _fn(ctx)
+}
+
+func (_recv *M0) _ContextTwoResults_Do(fn func(context.Context) (pkg.Int, error)) {
+	mock.Do(_M0Instance(_recv, "M0.ContextTwoResults"), (*_M0Data)._ContextTwoResults, fn)
+}
+
+func (_recv *M0) _ContextTwoResults_DoT(t testing.TB, fn func(context.Context) (pkg.Int, error)) {
+	mock.DoT(t, _M0Instance(_recv, "M0.ContextTwoResults"), (*_M0Data)._ContextTwoResults, fn)
+}
+
+func (M0) _ContextTwoResults_DoAll(t testing.TB, fn func(context.Context) (pkg.Int, error)) {
+	t.Helper()
+	mock.Serial(t, "M0.ContextTwoResults")
+	mock.DoAll(t, _M0.Global(), (*_M0Data)._ContextTwoResults, fn)
+}
+
+func (_recv *M0) _ContextTwoResults_Stub() {
+	_recv._ContextTwoResults_Do(func(context.Context) (r0 pkg.Int, r1 error) { return })
+}
+
+func (_recv *M0) _ContextTwoResults_StubT(t testing.TB) {
+	_recv._ContextTwoResults_DoT(t, func(context.Context) (r0 pkg.Int, r1 error) { return })
+}
+
+func (M0) _ContextTwoResults_StubAll(t testing.TB) {
+	t.Helper()
+	new(M0)._ContextTwoResults_DoAll(t, func(context.Context) (r0 pkg.Int, r1 error) { return })
+}
+
+func (_recv *M0) _ContextTwoResults_Return(r0 pkg.Int, r1 error) {
+	_recv._ContextTwoResults_Do(func(context.Context) (pkg.Int, error) { return r0, r1 })
+}
+
+func (_recv *M0) _ContextTwoResults_ReturnT(t testing.TB, r0 pkg.Int, r1 error) {
+	_recv._ContextTwoResults_DoT(t, func(context.Context) (pkg.Int, error) { return r0, r1 })
+}
+
+func (M0) _ContextTwoResults_ReturnAll(t testing.TB, r0 pkg.Int, r1 error) {
+	t.Helper()
+	new(M0)._ContextTwoResults_DoAll(t, func(context.Context) (pkg.Int, error) { return r0, r1 })
+}
+
+func (_recv *M0) _ContextTwoResults_Calls() []_M0_ContextTwoResults_Call {
+	return mock.Calls(_M0Instance(_recv, "M0.ContextTwoResults"), (*_M0Data)._ContextTwoResults)
+}
+
+func (M0) _ContextTwoResults_AllCalls() []_M0_ContextTwoResults_Call {
+	return mock.Calls(_M0.Global(), (*_M0Data)._ContextTwoResults)
+}
+
+func (M0) _ContextTwoResults_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M0.ContextTwoResults")
+	mock.Bubble(t, _M0.Global(), func(_dat *_M0Data) { _dat.ContextTwoResults.Calls.Clear() }, &_M0Policy.ContextTwoResults)
+}
+
+func (M0) _ContextTwoResults_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M0.ContextTwoResults")
+	_M0Policy.ContextTwoResults.Record(t, limit)
 }
 
-func (M0) _AllNamedIdentifiers_BubbleCalls(t *testing.T) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	_dat.AllNamedIdentifiersCalls = []_M0_AllNamedIdentifiers_Call{}
-	t.Cleanup(func() {
-		defer _dat.mutex.Unlock()
-		_dat.mutex.Lock()
-		_dat.AllNamedIdentifiersCalls = []_M0_AllNamedIdentifiers_Call{}
-	})
+func (_dat *_M0Data) _MixedNoResult() *mock.Method[func(pkg.String, ...pkg.String), _M0_MixedNoResult_Call] {
+	return &_dat.MixedNoResult
 }
 
 func (_recv *M0) MixedNoResult(P0 pkg.String, P1 ...pkg.String) {
 	if _recv == nil {
 		panic("M0.MixedNoResult: nil pointer receiver")
 	}
-	_dat := _M0PtrData(_recv)
-	_dat.mutex.Lock()
-	_dat.MixedNoResultCalls = append(_dat.MixedNoResultCalls, _M0_MixedNoResult_Call{P0, P1})
-	_all := _M0PtrData(nil)
-	_all.mutex.Lock()
-	_all.MixedNoResultCalls = append(_all.MixedNoResultCalls, _M0_MixedNoResult_Call{P0, P1})
-	var _fn func(pkg.String, ...pkg.String)
-	if len(_dat.MixedNoResultMocks) > 0 {
-		_fn = _dat.MixedNoResultMocks[0]
-		if len(_dat.MixedNoResultMocks) > 1 {
-			_dat.MixedNoResultMocks = _dat.MixedNoResultMocks[1:]
-		}
-	} else if len(_all.MixedNoResultMocks) > 0 {
-		_fn = _all.MixedNoResultMocks[0]
-		if len(_all.MixedNoResultMocks) > 1 {
-			_all.MixedNoResultMocks = _all.MixedNoResultMocks[1:]
-		}
-	} else {
+	if _M0.Idle(&_M0Policy.MixedNoResult) {
+		_recv.T0.MixedNoResult(P0, P1...)
+		return
+	}
+	_fn, _ok := mock.Next(_M0Entry(_recv), _M0.Global(), (*_M0Data)._MixedNoResult, &_M0Policy.MixedNoResult, _M0_MixedNoResult_Call{P0, P1})
+	if !_ok {
 		_fn = _recv.T0.MixedNoResult
 	}
-	_dat.mutex.Unlock()
-	_all.mutex.Unlock()
 	_fn(P0, P1...)
 }
 
 func (_recv *M0) _MixedNoResult_Do(fn func(pkg.String, ...pkg.String)) {
-	if _recv == nil {
-		panic("M0.MixedNoResult: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.MixedNoResultMocks = []func(pkg.String, ...pkg.String){}
-	} else if len(_dat.MixedNoResultMocks) < 2 {
-		_dat.MixedNoResultMocks = []func(pkg.String, ...pkg.String){fn, fn}
-	} else {
-		_dat.MixedNoResultMocks = _dat.MixedNoResultMocks[:len(_dat.MixedNoResultMocks)-1]
-		_dat.MixedNoResultMocks = append(_dat.MixedNoResultMocks, fn)
-		_dat.MixedNoResultMocks = append(_dat.MixedNoResultMocks, fn)
-	}
-}
-
-func (M0) _MixedNoResult_DoAll(t *testing.T, fn func(pkg.String, ...pkg.String)) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.MixedNoResultMocks = []func(pkg.String, ...pkg.String){}
-	} else if len(_dat.MixedNoResultMocks) < 2 {
-		_dat.MixedNoResultMocks = []func(pkg.String, ...pkg.String){fn, fn}
-	} else {
-		_dat.MixedNoResultMocks = _dat.MixedNoResultMocks[:len(_dat.MixedNoResultMocks)-1]
-		_dat.MixedNoResultMocks = append(_dat.MixedNoResultMocks, fn)
-		_dat.MixedNoResultMocks = append(_dat.MixedNoResultMocks, fn)
-	}
-	_dat.once.Do(func() {
-		t.Cleanup(func() {
-			defer _dat.mutex.Unlock()
-			_dat.mutex.Lock()
-			_dat.MixedNoResultMocks = []func(pkg.String, ...pkg.String){}
-			_dat.once = sync.Once{}
-		})
-	})
+	mock.Do(_M0Instance(_recv, "M0.MixedNoResult"), (*_M0Data)._MixedNoResult, fn)
+}
+
+func (_recv *M0) _MixedNoResult_DoT(t testing.TB, fn func(pkg.String, ...pkg.String)) {
+	mock.DoT(t, _M0Instance(_recv, "M0.MixedNoResult"), (*_M0Data)._MixedNoResult, fn)
+}
+
+func (M0) _MixedNoResult_DoAll(t testing.TB, fn func(pkg.String, ...pkg.String)) {
+	t.Helper()
+	mock.Serial(t, "M0.MixedNoResult")
+	mock.DoAll(t, _M0.Global(), (*_M0Data)._MixedNoResult, fn)
 }
 
 func (_recv *M0) _MixedNoResult_Stub() {
 	_recv._MixedNoResult_Do(func(pkg.String, ...pkg.String) { return })
 }
 
-func (M0) _MixedNoResult_StubAll(t *testing.T) {
+func (_recv *M0) _MixedNoResult_StubT(t testing.TB) {
+	_recv._MixedNoResult_DoT(t, func(pkg.String, ...pkg.String) { return })
+}
+
+func (M0) _MixedNoResult_StubAll(t testing.TB) {
+	t.Helper()
 	new(M0)._MixedNoResult_DoAll(t, func(pkg.String, ...pkg.String) { return })
 }
 
@@ -358,114 +634,77 @@ func (_recv *M0) _MixedNoResult_Return() {
 	_recv._MixedNoResult_Do(func(pkg.String, ...pkg.String) { return })
 }
 
-func (M0) _MixedNoResult_ReturnAll(t *testing.T) {
+func (_recv *M0) _MixedNoResult_ReturnT(t testing.TB) {
+	_recv._MixedNoResult_DoT(t, func(pkg.String, ...pkg.String) { return })
+}
+
+func (M0) _MixedNoResult_ReturnAll(t testing.TB) {
+	t.Helper()
 	new(M0)._MixedNoResult_DoAll(t, func(pkg.String, ...pkg.String) { return })
 }
 
 func (_recv *M0) _MixedNoResult_Calls() []_M0_MixedNoResult_Call {
-	if _recv == nil {
-		panic("M0.MixedNoResult: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.MixedNoResultCalls
+	return mock.Calls(_M0Instance(_recv, "M0.MixedNoResult"), (*_M0Data)._MixedNoResult)
 }
 
 func (M0) _MixedNoResult_AllCalls() []_M0_MixedNoResult_Call {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.MixedNoResultCalls
+	return mock.Calls(_M0.Global(), (*_M0Data)._MixedNoResult)
+}
+
+func (M0) _MixedNoResult_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M0.MixedNoResult")
+	mock.Bubble(t, _M0.Global(), func(_dat *_M0Data) { _dat.MixedNoResult.Calls.Clear() }, &_M0Policy.MixedNoResult)
+}
+
+func (M0) _MixedNoResult_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M0.MixedNoResult")
+	_M0Policy.MixedNoResult.Record(t, limit)
 }
 
-func (M0) _MixedNoResult_BubbleCalls(t *testing.T) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	_dat.MixedNoResultCalls = []_M0_MixedNoResult_Call{}
-	t.Cleanup(func() {
-		defer _dat.mutex.Unlock()
-		_dat.mutex.Lock()
-		_dat.MixedNoResultCalls = []_M0_MixedNoResult_Call{}
-	})
+func (_dat *_M0Data) _MixedOneResult() *mock.Method[func(pkg.String, ...pkg.String) error, _M0_MixedOneResult_Call] {
+	return &_dat.MixedOneResult
 }
 
 func (_recv *M0) MixedOneResult(P0 pkg.String, P1 ...pkg.String) error {
 	if _recv == nil {
 		panic("M0.MixedOneResult: nil pointer receiver")
 	}
-	_dat := _M0PtrData(_recv)
-	_dat.mutex.Lock()
-	_dat.MixedOneResultCalls = append(_dat.MixedOneResultCalls, _M0_MixedOneResult_Call{P0, P1})
-	_all := _M0PtrData(nil)
-	_all.mutex.Lock()
-	_all.MixedOneResultCalls = append(_all.MixedOneResultCalls, _M0_MixedOneResult_Call{P0, P1})
-	var _fn func(pkg.String, ...pkg.String) error
-	if len(_dat.MixedOneResultMocks) > 0 {
-		_fn = _dat.MixedOneResultMocks[0]
-		if len(_dat.MixedOneResultMocks) > 1 {
-			_dat.MixedOneResultMocks = _dat.MixedOneResultMocks[1:]
-		}
-	} else if len(_all.MixedOneResultMocks) > 0 {
-		_fn = _all.MixedOneResultMocks[0]
-		if len(_all.MixedOneResultMocks) > 1 {
-			_all.MixedOneResultMocks = _all.MixedOneResultMocks[1:]
-		}
-	} else {
+	if _M0.Idle(&_M0Policy.MixedOneResult) {
+		return _recv.T0.MixedOneResult(P0, P1...)
+	}
+	_fn, _ok := mock.Next(_M0Entry(_recv), _M0.Global(), (*_M0Data)._MixedOneResult, &_M0Policy.MixedOneResult, _M0_MixedOneResult_Call{P0, P1})
+	if !_ok {
 		_fn = _recv.T0.MixedOneResult
 	}
-	_dat.mutex.Unlock()
-	_all.mutex.Unlock()
 	return _fn(P0, P1...)
 }
 
 func (_recv *M0) _MixedOneResult_Do(fn func(pkg.String, ...pkg.String) error) {
-	if _recv == nil {
-		panic("M0.MixedOneResult: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.MixedOneResultMocks = []func(pkg.String, ...pkg.String) error{}
-	} else if len(_dat.MixedOneResultMocks) < 2 {
-		_dat.MixedOneResultMocks = []func(pkg.String, ...pkg.String) error{fn, fn}
-	} else {
-		_dat.MixedOneResultMocks = _dat.MixedOneResultMocks[:len(_dat.MixedOneResultMocks)-1]
-		_dat.MixedOneResultMocks = append(_dat.MixedOneResultMocks, fn)
-		_dat.MixedOneResultMocks = append(_dat.MixedOneResultMocks, fn)
-	}
-}
-
-func (M0) _MixedOneResult_DoAll(t *testing.T, fn func(pkg.String, ...pkg.String) error) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.MixedOneResultMocks = []func(pkg.String, ...pkg.String) error{}
-	} else if len(_dat.MixedOneResultMocks) < 2 {
-		_dat.MixedOneResultMocks = []func(pkg.String, ...pkg.String) error{fn, fn}
-	} else {
-		_dat.MixedOneResultMocks = _dat.MixedOneResultMocks[:len(_dat.MixedOneResultMocks)-1]
-		_dat.MixedOneResultMocks = append(_dat.MixedOneResultMocks, fn)
-		_dat.MixedOneResultMocks = append(_dat.MixedOneResultMocks, fn)
-	}
-	_dat.once.Do(func() {
-		t.Cleanup(func() {
-			defer _dat.mutex.Unlock()
-			_dat.mutex.Lock()
-			_dat.MixedOneResultMocks = []func(pkg.String, ...pkg.String) error{}
-			_dat.once = sync.Once{}
-		})
-	})
+	mock.Do(_M0Instance(_recv, "M0.MixedOneResult"), (*_M0Data)._MixedOneResult, fn)
+}
+
+func (_recv *M0) _MixedOneResult_DoT(t testing.TB, fn func(pkg.String, ...pkg.String) error) {
+	mock.DoT(t, _M0Instance(_recv, "M0.MixedOneResult"), (*_M0Data)._MixedOneResult, fn)
+}
+
+func (M0) _MixedOneResult_DoAll(t testing.TB, fn func(pkg.String, ...pkg.String) error) {
+	t.Helper()
+	mock.Serial(t, "M0.MixedOneResult")
+	mock.DoAll(t, _M0.Global(), (*_M0Data)._MixedOneResult, fn)
 }
 
 func (_recv *M0) _MixedOneResult_Stub() {
 	_recv._MixedOneResult_Do(func(pkg.String, ...pkg.String) (r0 error) { return })
 }
 
-func (M0) _MixedOneResult_StubAll(t *testing.T) {
+func (_recv *M0) _MixedOneResult_StubT(t testing.TB) {
+	_recv._MixedOneResult_DoT(t, func(pkg.String, ...pkg.String) (r0 error) { return })
+}
+
+func (M0) _MixedOneResult_StubAll(t testing.TB) {
+	t.Helper()
 	new(M0)._MixedOneResult_DoAll(t, func(pkg.String, ...pkg.String) (r0 error) { return })
 }
 
@@ -473,114 +712,77 @@ func (_recv *M0) _MixedOneResult_Return(r0 error) {
 	_recv._MixedOneResult_Do(func(pkg.String, ...pkg.String) error { return r0 })
 }
 
-func (M0) _MixedOneResult_ReturnAll(t *testing.T, r0 error) {
+func (_recv *M0) _MixedOneResult_ReturnT(t testing.TB, r0 error) {
+	_recv._MixedOneResult_DoT(t, func(pkg.String, ...pkg.String) error { return r0 })
+}
+
+func (M0) _MixedOneResult_ReturnAll(t testing.TB, r0 error) {
+	t.Helper()
 	new(M0)._MixedOneResult_DoAll(t, func(pkg.String, ...pkg.String) error { return r0 })
 }
 
 func (_recv *M0) _MixedOneResult_Calls() []_M0_MixedOneResult_Call {
-	if _recv == nil {
-		panic("M0.MixedOneResult: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.MixedOneResultCalls
+	return mock.Calls(_M0Instance(_recv, "M0.MixedOneResult"), (*_M0Data)._MixedOneResult)
 }
 
 func (M0) _MixedOneResult_AllCalls() []_M0_MixedOneResult_Call {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.MixedOneResultCalls
+	return mock.Calls(_M0.Global(), (*_M0Data)._MixedOneResult)
+}
+
+func (M0) _MixedOneResult_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M0.MixedOneResult")
+	mock.Bubble(t, _M0.Global(), func(_dat *_M0Data) { _dat.MixedOneResult.Calls.Clear() }, &_M0Policy.MixedOneResult)
 }
 
-func (M0) _MixedOneResult_BubbleCalls(t *testing.T) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	_dat.MixedOneResultCalls = []_M0_MixedOneResult_Call{}
-	t.Cleanup(func() {
-		defer _dat.mutex.Unlock()
-		_dat.mutex.Lock()
-		_dat.MixedOneResultCalls = []_M0_MixedOneResult_Call{}
-	})
+func (M0) _MixedOneResult_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M0.MixedOneResult")
+	_M0Policy.MixedOneResult.Record(t, limit)
+}
+
+func (_dat *_M0Data) _MixedTwoResults() *mock.Method[func(pkg.String, ...pkg.String) (pkg.Int, error), _M0_MixedTwoResults_Call] {
+	return &_dat.MixedTwoResults
 }
 
 func (_recv *M0) MixedTwoResults(P0 pkg.String, P1 ...pkg.String) (pkg.Int, error) {
 	if _recv == nil {
 		panic("M0.MixedTwoResults: nil pointer receiver")
 	}
-	_dat := _M0PtrData(_recv)
-	_dat.mutex.Lock()
-	_dat.MixedTwoResultsCalls = append(_dat.MixedTwoResultsCalls, _M0_MixedTwoResults_Call{P0, P1})
-	_all := _M0PtrData(nil)
-	_all.mutex.Lock()
-	_all.MixedTwoResultsCalls = append(_all.MixedTwoResultsCalls, _M0_MixedTwoResults_Call{P0, P1})
-	var _fn func(pkg.String, ...pkg.String) (pkg.Int, error)
-	if len(_dat.MixedTwoResultsMocks) > 0 {
-		_fn = _dat.MixedTwoResultsMocks[0]
-		if len(_dat.MixedTwoResultsMocks) > 1 {
-			_dat.MixedTwoResultsMocks = _dat.MixedTwoResultsMocks[1:]
-		}
-	} else if len(_all.MixedTwoResultsMocks) > 0 {
-		_fn = _all.MixedTwoResultsMocks[0]
-		if len(_all.MixedTwoResultsMocks) > 1 {
-			_all.MixedTwoResultsMocks = _all.MixedTwoResultsMocks[1:]
-		}
-	} else {
+	if _M0.Idle(&_M0Policy.MixedTwoResults) {
+		return _recv.T0.MixedTwoResults(P0, P1...)
+	}
+	_fn, _ok := mock.Next(_M0Entry(_recv), _M0.Global(), (*_M0Data)._MixedTwoResults, &_M0Policy.MixedTwoResults, _M0_MixedTwoResults_Call{P0, P1})
+	if !_ok {
 		_fn = _recv.T0.MixedTwoResults
 	}
-	_dat.mutex.Unlock()
-	_all.mutex.Unlock()
 	return _fn(P0, P1...)
 }
 
 func (_recv *M0) _MixedTwoResults_Do(fn func(pkg.String, ...pkg.String) (pkg.Int, error)) {
-	if _recv == nil {
-		panic("M0.MixedTwoResults: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.MixedTwoResultsMocks = []func(pkg.String, ...pkg.String) (pkg.Int, error){}
-	} else if len(_dat.MixedTwoResultsMocks) < 2 {
-		_dat.MixedTwoResultsMocks = []func(pkg.String, ...pkg.String) (pkg.Int, error){fn, fn}
-	} else {
-		_dat.MixedTwoResultsMocks = _dat.MixedTwoResultsMocks[:len(_dat.MixedTwoResultsMocks)-1]
-		_dat.MixedTwoResultsMocks = append(_dat.MixedTwoResultsMocks, fn)
-		_dat.MixedTwoResultsMocks = append(_dat.MixedTwoResultsMocks, fn)
-	}
-}
-
-func (M0) _MixedTwoResults_DoAll(t *testing.T, fn func(pkg.String, ...pkg.String) (pkg.Int, error)) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.MixedTwoResultsMocks = []func(pkg.String, ...pkg.String) (pkg.Int, error){}
-	} else if len(_dat.MixedTwoResultsMocks) < 2 {
-		_dat.MixedTwoResultsMocks = []func(pkg.String, ...pkg.String) (pkg.Int, error){fn, fn}
-	} else {
-		_dat.MixedTwoResultsMocks = _dat.MixedTwoResultsMocks[:len(_dat.MixedTwoResultsMocks)-1]
-		_dat.MixedTwoResultsMocks = append(_dat.MixedTwoResultsMocks, fn)
-		_dat.MixedTwoResultsMocks = append(_dat.MixedTwoResultsMocks, fn)
-	}
-	_dat.once.Do(func() {
-		t.Cleanup(func() {
-			defer _dat.mutex.Unlock()
-			_dat.mutex.Lock()
-			_dat.MixedTwoResultsMocks = []func(pkg.String, ...pkg.String) (pkg.Int, error){}
-			_dat.once = sync.Once{}
-		})
-	})
+	mock.Do(_M0Instance(_recv, "M0.MixedTwoResults"), (*_M0Data)._MixedTwoResults, fn)
+}
+
+func (_recv *M0) _MixedTwoResults_DoT(t testing.TB, fn func(pkg.String, ...pkg.String) (pkg.Int, error)) {
+	mock.DoT(t, _M0Instance(_recv, "M0.MixedTwoResults"), (*_M0Data)._MixedTwoResults, fn)
+}
+
+func (M0) _MixedTwoResults_DoAll(t testing.TB, fn func(pkg.String, ...pkg.String) (pkg.Int, error)) {
+	t.Helper()
+	mock.Serial(t, "M0.MixedTwoResults")
+	mock.DoAll(t, _M0.Global(), (*_M0Data)._MixedTwoResults, fn)
 }
 
 func (_recv *M0) _MixedTwoResults_Stub() {
 	_recv._MixedTwoResults_Do(func(pkg.String, ...pkg.String) (r0 pkg.Int, r1 error) { return })
 }
 
-func (M0) _MixedTwoResults_StubAll(t *testing.T) {
+func (_recv *M0) _MixedTwoResults_StubT(t testing.TB) {
+	_recv._MixedTwoResults_DoT(t, func(pkg.String, ...pkg.String) (r0 pkg.Int, r1 error) { return })
+}
+
+func (M0) _MixedTwoResults_StubAll(t testing.TB) {
+	t.Helper()
 	new(M0)._MixedTwoResults_DoAll(t, func(pkg.String, ...pkg.String) (r0 pkg.Int, r1 error) { return })
 }
 
@@ -588,114 +790,78 @@ func (_recv *M0) _MixedTwoResults_Return(r0 pkg.Int, r1 error) {
 	_recv._MixedTwoResults_Do(func(pkg.String, ...pkg.String) (pkg.Int, error) { return r0, r1 })
 }
 
-func (M0) _MixedTwoResults_ReturnAll(t *testing.T, r0 pkg.Int, r1 error) {
+func (_recv *M0) _MixedTwoResults_ReturnT(t testing.TB, r0 pkg.Int, r1 error) {
+	_recv._MixedTwoResults_DoT(t, func(pkg.String, ...pkg.String) (pkg.Int, error) { return r0, r1 })
+}
+
+func (M0) _MixedTwoResults_ReturnAll(t testing.TB, r0 pkg.Int, r1 error) {
+	t.Helper()
 	new(M0)._MixedTwoResults_DoAll(t, func(pkg.String, ...pkg.String) (pkg.Int, error) { return r0, r1 })
 }
 
 func (_recv *M0) _MixedTwoResults_Calls() []_M0_MixedTwoResults_Call {
-	if _recv == nil {
-		panic("M0.MixedTwoResults: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.MixedTwoResultsCalls
+	return mock.Calls(_M0Instance(_recv, "M0.MixedTwoResults"), (*_M0Data)._MixedTwoResults)
 }
 
 func (M0) _MixedTwoResults_AllCalls() []_M0_MixedTwoResults_Call {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.MixedTwoResultsCalls
+	return mock.Calls(_M0.Global(), (*_M0Data)._MixedTwoResults)
+}
+
+func (M0) _MixedTwoResults_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M0.MixedTwoResults")
+	mock.Bubble(t, _M0.Global(), func(_dat *_M0Data) { _dat.MixedTwoResults.Calls.Clear() }, &_M0Policy.MixedTwoResults)
+}
+
+func (M0) _MixedTwoResults_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M0.MixedTwoResults")
+	_M0Policy.MixedTwoResults.Record(t, limit)
 }
 
-func (M0) _MixedTwoResults_BubbleCalls(t *testing.T) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	_dat.MixedTwoResultsCalls = []_M0_MixedTwoResults_Call{}
-	t.Cleanup(func() {
-		defer _dat.mutex.Unlock()
-		_dat.mutex.Lock()
-		_dat.MixedTwoResultsCalls = []_M0_MixedTwoResults_Call{}
-	})
+func (_dat *_M0Data) _NamedMixedNoResult() *mock.Method[func(pkg.String, ...pkg.String), _M0_NamedMixedNoResult_Call] {
+	return &_dat.NamedMixedNoResult
 }
 
 func (_recv *M0) NamedMixedNoResult(x pkg.String, y ...pkg.String) {
 	if _recv == nil {
 		panic("M0.NamedMixedNoResult: nil pointer receiver")
 	}
-	_dat := _M0PtrData(_recv)
-	_dat.mutex.Lock()
-	_dat.NamedMixedNoResultCalls = append(_dat.NamedMixedNoResultCalls, _M0_NamedMixedNoResult_Call{x, y})
-	_all := _M0PtrData(nil)
-	_all.mutex.Lock()
-	_all.NamedMixedNoResultCalls = append(_all.NamedMixedNoResultCalls, _M0_NamedMixedNoResult_Call{x, y})
-	var _fn func(pkg.String, ...pkg.String)
-	if len(_dat.NamedMixedNoResultMocks) > 0 {
-		_fn = _dat.NamedMixedNoResultMocks[0]
-		if len(_dat.NamedMixedNoResultMocks) > 1 {
-			_dat.NamedMixedNoResultMocks = _dat.NamedMixedNoResultMocks[1:]
-		}
-	} else if len(_all.NamedMixedNoResultMocks) > 0 {
-		_fn = _all.NamedMixedNoResultMocks[0]
-		if len(_all.NamedMixedNoResultMocks) > 1 {
-			_all.NamedMixedNoResultMocks = _all.NamedMixedNoResultMocks[1:]
-		}
-	} else {
+	if _M0.Idle(&_M0Policy.NamedMixedNoResult) {
+		_recv.T0.NamedMixedNoResult(x, y...)
+		return
+	}
+	_fn, _ok := mock.Next(_M0Entry(_recv), _M0.Global(), (*_M0Data)._NamedMixedNoResult, &_M0Policy.NamedMixedNoResult, _M0_NamedMixedNoResult_Call{x, y})
+	if !_ok {
 		_fn = _recv.T0.NamedMixedNoResult
 	}
-	_dat.mutex.Unlock()
-	_all.mutex.Unlock()
 	_fn(x, y...)
 }
 
 func (_recv *M0) _NamedMixedNoResult_Do(fn func(pkg.String, ...pkg.String)) {
-	if _recv == nil {
-		panic("M0.NamedMixedNoResult: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.NamedMixedNoResultMocks = []func(pkg.String, ...pkg.String){}
-	} else if len(_dat.NamedMixedNoResultMocks) < 2 {
-		_dat.NamedMixedNoResultMocks = []func(pkg.String, ...pkg.String){fn, fn}
-	} else {
-		_dat.NamedMixedNoResultMocks = _dat.NamedMixedNoResultMocks[:len(_dat.NamedMixedNoResultMocks)-1]
-		_dat.NamedMixedNoResultMocks = append(_dat.NamedMixedNoResultMocks, fn)
-		_dat.NamedMixedNoResultMocks = append(_dat.NamedMixedNoResultMocks, fn)
-	}
-}
-
-func (M0) _NamedMixedNoResult_DoAll(t *testing.T, fn func(pkg.String, ...pkg.String)) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.NamedMixedNoResultMocks = []func(pkg.String, ...pkg.String){}
-	} else if len(_dat.NamedMixedNoResultMocks) < 2 {
-		_dat.NamedMixedNoResultMocks = []func(pkg.String, ...pkg.String){fn, fn}
-	} else {
-		_dat.NamedMixedNoResultMocks = _dat.NamedMixedNoResultMocks[:len(_dat.NamedMixedNoResultMocks)-1]
-		_dat.NamedMixedNoResultMocks = append(_dat.NamedMixedNoResultMocks, fn)
-		_dat.NamedMixedNoResultMocks = append(_dat.NamedMixedNoResultMocks, fn)
-	}
-	_dat.once.Do(func() {
-		t.Cleanup(func() {
-			defer _dat.mutex.Unlock()
-			_dat.mutex.Lock()
-			_dat.NamedMixedNoResultMocks = []func(pkg.String, ...pkg.String){}
-			_dat.once = sync.Once{}
-		})
-	})
+	mock.Do(_M0Instance(_recv, "M0.NamedMixedNoResult"), (*_M0Data)._NamedMixedNoResult, fn)
+}
+
+func (_recv *M0) _NamedMixedNoResult_DoT(t testing.TB, fn func(pkg.String, ...pkg.String)) {
+	mock.DoT(t, _M0Instance(_recv, "M0.NamedMixedNoResult"), (*_M0Data)._NamedMixedNoResult, fn)
+}
+
+func (M0) _NamedMixedNoResult_DoAll(t testing.TB, fn func(pkg.String, ...pkg.String)) {
+	t.Helper()
+	mock.Serial(t, "M0.NamedMixedNoResult")
+	mock.DoAll(t, _M0.Global(), (*_M0Data)._NamedMixedNoResult, fn)
 }
 
 func (_recv *M0) _NamedMixedNoResult_Stub() {
 	_recv._NamedMixedNoResult_Do(func(pkg.String, ...pkg.String) { return })
 }
 
-func (M0) _NamedMixedNoResult_StubAll(t *testing.T) {
+func (_recv *M0) _NamedMixedNoResult_StubT(t testing.TB) {
+	_recv._NamedMixedNoResult_DoT(t, func(pkg.String, ...pkg.String) { return })
+}
+
+func (M0) _NamedMixedNoResult_StubAll(t testing.TB) {
+	t.Helper()
 	new(M0)._NamedMixedNoResult_DoAll(t, func(pkg.String, ...pkg.String) { return })
 }
 
@@ -703,114 +869,77 @@ func (_recv *M0) _NamedMixedNoResult_Return() {
 	_recv._NamedMixedNoResult_Do(func(pkg.String, ...pkg.String) { return })
 }
 
-func (M0) _NamedMixedNoResult_ReturnAll(t *testing.T) {
+func (_recv *M0) _NamedMixedNoResult_ReturnT(t testing.TB) {
+	_recv._NamedMixedNoResult_DoT(t, func(pkg.String, ...pkg.String) { return })
+}
+
+func (M0) _NamedMixedNoResult_ReturnAll(t testing.TB) {
+	t.Helper()
 	new(M0)._NamedMixedNoResult_DoAll(t, func(pkg.String, ...pkg.String) { return })
 }
 
 func (_recv *M0) _NamedMixedNoResult_Calls() []_M0_NamedMixedNoResult_Call {
-	if _recv == nil {
-		panic("M0.NamedMixedNoResult: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.NamedMixedNoResultCalls
+	return mock.Calls(_M0Instance(_recv, "M0.NamedMixedNoResult"), (*_M0Data)._NamedMixedNoResult)
 }
 
 func (M0) _NamedMixedNoResult_AllCalls() []_M0_NamedMixedNoResult_Call {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.NamedMixedNoResultCalls
+	return mock.Calls(_M0.Global(), (*_M0Data)._NamedMixedNoResult)
 }
 
-func (M0) _NamedMixedNoResult_BubbleCalls(t *testing.T) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	_dat.NamedMixedNoResultCalls = []_M0_NamedMixedNoResult_Call{}
-	t.Cleanup(func() {
-		defer _dat.mutex.Unlock()
-		_dat.mutex.Lock()
-		_dat.NamedMixedNoResultCalls = []_M0_NamedMixedNoResult_Call{}
-	})
+func (M0) _NamedMixedNoResult_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M0.NamedMixedNoResult")
+	mock.Bubble(t, _M0.Global(), func(_dat *_M0Data) { _dat.NamedMixedNoResult.Calls.Clear() }, &_M0Policy.NamedMixedNoResult)
+}
+
+func (M0) _NamedMixedNoResult_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M0.NamedMixedNoResult")
+	_M0Policy.NamedMixedNoResult.Record(t, limit)
+}
+
+func (_dat *_M0Data) _NamedMixedOneResult() *mock.Method[func(pkg.String, ...pkg.String) error, _M0_NamedMixedOneResult_Call] {
+	return &_dat.NamedMixedOneResult
 }
 
 func (_recv *M0) NamedMixedOneResult(x pkg.String, y ...pkg.String) error {
 	if _recv == nil {
 		panic("M0.NamedMixedOneResult: nil pointer receiver")
 	}
-	_dat := _M0PtrData(_recv)
-	_dat.mutex.Lock()
-	_dat.NamedMixedOneResultCalls = append(_dat.NamedMixedOneResultCalls, _M0_NamedMixedOneResult_Call{x, y})
-	_all := _M0PtrData(nil)
-	_all.mutex.Lock()
-	_all.NamedMixedOneResultCalls = append(_all.NamedMixedOneResultCalls, _M0_NamedMixedOneResult_Call{x, y})
-	var _fn func(pkg.String, ...pkg.String) error
-	if len(_dat.NamedMixedOneResultMocks) > 0 {
-		_fn = _dat.NamedMixedOneResultMocks[0]
-		if len(_dat.NamedMixedOneResultMocks) > 1 {
-			_dat.NamedMixedOneResultMocks = _dat.NamedMixedOneResultMocks[1:]
-		}
-	} else if len(_all.NamedMixedOneResultMocks) > 0 {
-		_fn = _all.NamedMixedOneResultMocks[0]
-		if len(_all.NamedMixedOneResultMocks) > 1 {
-			_all.NamedMixedOneResultMocks = _all.NamedMixedOneResultMocks[1:]
-		}
-	} else {
+	if _M0.Idle(&_M0Policy.NamedMixedOneResult) {
+		return _recv.T0.NamedMixedOneResult(x, y...)
+	}
+	_fn, _ok := mock.Next(_M0Entry(_recv), _M0.Global(), (*_M0Data)._NamedMixedOneResult, &_M0Policy.NamedMixedOneResult, _M0_NamedMixedOneResult_Call{x, y})
+	if !_ok {
 		_fn = _recv.T0.NamedMixedOneResult
 	}
-	_dat.mutex.Unlock()
-	_all.mutex.Unlock()
 	return _fn(x, y...)
 }
 
 func (_recv *M0) _NamedMixedOneResult_Do(fn func(pkg.String, ...pkg.String) error) {
-	if _recv == nil {
-		panic("M0.NamedMixedOneResult: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.NamedMixedOneResultMocks = []func(pkg.String, ...pkg.String) error{}
-	} else if len(_dat.NamedMixedOneResultMocks) < 2 {
-		_dat.NamedMixedOneResultMocks = []func(pkg.String, ...pkg.String) error{fn, fn}
-	} else {
-		_dat.NamedMixedOneResultMocks = _dat.NamedMixedOneResultMocks[:len(_dat.NamedMixedOneResultMocks)-1]
-		_dat.NamedMixedOneResultMocks = append(_dat.NamedMixedOneResultMocks, fn)
-		_dat.NamedMixedOneResultMocks = append(_dat.NamedMixedOneResultMocks, fn)
-	}
-}
-
-func (M0) _NamedMixedOneResult_DoAll(t *testing.T, fn func(pkg.String, ...pkg.String) error) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.NamedMixedOneResultMocks = []func(pkg.String, ...pkg.String) error{}
-	} else if len(_dat.NamedMixedOneResultMocks) < 2 {
-		_dat.NamedMixedOneResultMocks = []func(pkg.String, ...pkg.String) error{fn, fn}
-	} else {
-		_dat.NamedMixedOneResultMocks = _dat.NamedMixedOneResultMocks[:len(_dat.NamedMixedOneResultMocks)-1]
-		_dat.NamedMixedOneResultMocks = append(_dat.NamedMixedOneResultMocks, fn)
-		_dat.NamedMixedOneResultMocks = append(_dat.NamedMixedOneResultMocks, fn)
-	}
-	_dat.once.Do(func() {
-		t.Cleanup(func() {
-			defer _dat.mutex.Unlock()
-			_dat.mutex.Lock()
-			_dat.NamedMixedOneResultMocks = []func(pkg.String, ...pkg.String) error{}
-			_dat.once = sync.Once{}
-		})
-	})
+	mock.Do(_M0Instance(_recv, "M0.NamedMixedOneResult"), (*_M0Data)._NamedMixedOneResult, fn)
+}
+
+func (_recv *M0) _NamedMixedOneResult_DoT(t testing.TB, fn func(pkg.String, ...pkg.String) error) {
+	mock.DoT(t, _M0Instance(_recv, "M0.NamedMixedOneResult"), (*_M0Data)._NamedMixedOneResult, fn)
+}
+
+func (M0) _NamedMixedOneResult_DoAll(t testing.TB, fn func(pkg.String, ...pkg.String) error) {
+	t.Helper()
+	mock.Serial(t, "M0.NamedMixedOneResult")
+	mock.DoAll(t, _M0.Global(), (*_M0Data)._NamedMixedOneResult, fn)
 }
 
 func (_recv *M0) _NamedMixedOneResult_Stub() {
 	_recv._NamedMixedOneResult_Do(func(pkg.String, ...pkg.String) (r0 error) { return })
 }
 
-func (M0) _NamedMixedOneResult_StubAll(t *testing.T) {
+func (_recv *M0) _NamedMixedOneResult_StubT(t testing.TB) {
+	_recv._NamedMixedOneResult_DoT(t, func(pkg.String, ...pkg.String) (r0 error) { return })
+}
+
+func (M0) _NamedMixedOneResult_StubAll(t testing.TB) {
+	t.Helper()
 	new(M0)._NamedMixedOneResult_DoAll(t, func(pkg.String, ...pkg.String) (r0 error) { return })
 }
 
@@ -818,114 +947,77 @@ func (_recv *M0) _NamedMixedOneResult_Return(r0 error) {
 	_recv._NamedMixedOneResult_Do(func(pkg.String, ...pkg.String) error { return r0 })
 }
 
-func (M0) _NamedMixedOneResult_ReturnAll(t *testing.T, r0 error) {
+func (_recv *M0) _NamedMixedOneResult_ReturnT(t testing.TB, r0 error) {
+	_recv._NamedMixedOneResult_DoT(t, func(pkg.String, ...pkg.String) error { return r0 })
+}
+
+func (M0) _NamedMixedOneResult_ReturnAll(t testing.TB, r0 error) {
+	t.Helper()
 	new(M0)._NamedMixedOneResult_DoAll(t, func(pkg.String, ...pkg.String) error { return r0 })
 }
 
 func (_recv *M0) _NamedMixedOneResult_Calls() []_M0_NamedMixedOneResult_Call {
-	if _recv == nil {
-		panic("M0.NamedMixedOneResult: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.NamedMixedOneResultCalls
+	return mock.Calls(_M0Instance(_recv, "M0.NamedMixedOneResult"), (*_M0Data)._NamedMixedOneResult)
 }
 
 func (M0) _NamedMixedOneResult_AllCalls() []_M0_NamedMixedOneResult_Call {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.NamedMixedOneResultCalls
+	return mock.Calls(_M0.Global(), (*_M0Data)._NamedMixedOneResult)
+}
+
+func (M0) _NamedMixedOneResult_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M0.NamedMixedOneResult")
+	mock.Bubble(t, _M0.Global(), func(_dat *_M0Data) { _dat.NamedMixedOneResult.Calls.Clear() }, &_M0Policy.NamedMixedOneResult)
+}
+
+func (M0) _NamedMixedOneResult_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M0.NamedMixedOneResult")
+	_M0Policy.NamedMixedOneResult.Record(t, limit)
 }
 
-func (M0) _NamedMixedOneResult_BubbleCalls(t *testing.T) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	_dat.NamedMixedOneResultCalls = []_M0_NamedMixedOneResult_Call{}
-	t.Cleanup(func() {
-		defer _dat.mutex.Unlock()
-		_dat.mutex.Lock()
-		_dat.NamedMixedOneResultCalls = []_M0_NamedMixedOneResult_Call{}
-	})
+func (_dat *_M0Data) _NamedMixedTwoResults() *mock.Method[func(pkg.String, ...pkg.String) (pkg.Int, error), _M0_NamedMixedTwoResults_Call] {
+	return &_dat.NamedMixedTwoResults
 }
 
 func (_recv *M0) NamedMixedTwoResults(x pkg.String, y ...pkg.String) (pkg.Int, error) {
 	if _recv == nil {
 		panic("M0.NamedMixedTwoResults: nil pointer receiver")
 	}
-	_dat := _M0PtrData(_recv)
-	_dat.mutex.Lock()
-	_dat.NamedMixedTwoResultsCalls = append(_dat.NamedMixedTwoResultsCalls, _M0_NamedMixedTwoResults_Call{x, y})
-	_all := _M0PtrData(nil)
-	_all.mutex.Lock()
-	_all.NamedMixedTwoResultsCalls = append(_all.NamedMixedTwoResultsCalls, _M0_NamedMixedTwoResults_Call{x, y})
-	var _fn func(pkg.String, ...pkg.String) (pkg.Int, error)
-	if len(_dat.NamedMixedTwoResultsMocks) > 0 {
-		_fn = _dat.NamedMixedTwoResultsMocks[0]
-		if len(_dat.NamedMixedTwoResultsMocks) > 1 {
-			_dat.NamedMixedTwoResultsMocks = _dat.NamedMixedTwoResultsMocks[1:]
-		}
-	} else if len(_all.NamedMixedTwoResultsMocks) > 0 {
-		_fn = _all.NamedMixedTwoResultsMocks[0]
-		if len(_all.NamedMixedTwoResultsMocks) > 1 {
-			_all.NamedMixedTwoResultsMocks = _all.NamedMixedTwoResultsMocks[1:]
-		}
-	} else {
+	if _M0.Idle(&_M0Policy.NamedMixedTwoResults) {
+		return _recv.T0.NamedMixedTwoResults(x, y...)
+	}
+	_fn, _ok := mock.Next(_M0Entry(_recv), _M0.Global(), (*_M0Data)._NamedMixedTwoResults, &_M0Policy.NamedMixedTwoResults, _M0_NamedMixedTwoResults_Call{x, y})
+	if !_ok {
 		_fn = _recv.T0.NamedMixedTwoResults
 	}
-	_dat.mutex.Unlock()
-	_all.mutex.Unlock()
 	return _fn(x, y...)
 }
 
 func (_recv *M0) _NamedMixedTwoResults_Do(fn func(pkg.String, ...pkg.String) (pkg.Int, error)) {
-	if _recv == nil {
-		panic("M0.NamedMixedTwoResults: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.NamedMixedTwoResultsMocks = []func(pkg.String, ...pkg.String) (pkg.Int, error){}
-	} else if len(_dat.NamedMixedTwoResultsMocks) < 2 {
-		_dat.NamedMixedTwoResultsMocks = []func(pkg.String, ...pkg.String) (pkg.Int, error){fn, fn}
-	} else {
-		_dat.NamedMixedTwoResultsMocks = _dat.NamedMixedTwoResultsMocks[:len(_dat.NamedMixedTwoResultsMocks)-1]
-		_dat.NamedMixedTwoResultsMocks = append(_dat.NamedMixedTwoResultsMocks, fn)
-		_dat.NamedMixedTwoResultsMocks = append(_dat.NamedMixedTwoResultsMocks, fn)
-	}
-}
-
-func (M0) _NamedMixedTwoResults_DoAll(t *testing.T, fn func(pkg.String, ...pkg.String) (pkg.Int, error)) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.NamedMixedTwoResultsMocks = []func(pkg.String, ...pkg.String) (pkg.Int, error){}
-	} else if len(_dat.NamedMixedTwoResultsMocks) < 2 {
-		_dat.NamedMixedTwoResultsMocks = []func(pkg.String, ...pkg.String) (pkg.Int, error){fn, fn}
-	} else {
-		_dat.NamedMixedTwoResultsMocks = _dat.NamedMixedTwoResultsMocks[:len(_dat.NamedMixedTwoResultsMocks)-1]
-		_dat.NamedMixedTwoResultsMocks = append(_dat.NamedMixedTwoResultsMocks, fn)
-		_dat.NamedMixedTwoResultsMocks = append(_dat.NamedMixedTwoResultsMocks, fn)
-	}
-	_dat.once.Do(func() {
-		t.Cleanup(func() {
-			defer _dat.mutex.Unlock()
-			_dat.mutex.Lock()
-			_dat.NamedMixedTwoResultsMocks = []func(pkg.String, ...pkg.String) (pkg.Int, error){}
-			_dat.once = sync.Once{}
-		})
-	})
+	mock.Do(_M0Instance(_recv, "M0.NamedMixedTwoResults"), (*_M0Data)._NamedMixedTwoResults, fn)
+}
+
+func (_recv *M0) _NamedMixedTwoResults_DoT(t testing.TB, fn func(pkg.String, ...pkg.String) (pkg.Int, error)) {
+	mock.DoT(t, _M0Instance(_recv, "M0.NamedMixedTwoResults"), (*_M0Data)._NamedMixedTwoResults, fn)
+}
+
+func (M0) _NamedMixedTwoResults_DoAll(t testing.TB, fn func(pkg.String, ...pkg.String) (pkg.Int, error)) {
+	t.Helper()
+	mock.Serial(t, "M0.NamedMixedTwoResults")
+	mock.DoAll(t, _M0.Global(), (*_M0Data)._NamedMixedTwoResults, fn)
 }
 
 func (_recv *M0) _NamedMixedTwoResults_Stub() {
 	_recv._NamedMixedTwoResults_Do(func(pkg.String, ...pkg.String) (r0 pkg.Int, r1 error) { return })
 }
 
-func (M0) _NamedMixedTwoResults_StubAll(t *testing.T) {
+func (_recv *M0) _NamedMixedTwoResults_StubT(t testing.TB) {
+	_recv._NamedMixedTwoResults_DoT(t, func(pkg.String, ...pkg.String) (r0 pkg.Int, r1 error) { return })
+}
+
+func (M0) _NamedMixedTwoResults_StubAll(t testing.TB) {
+	t.Helper()
 	new(M0)._NamedMixedTwoResults_DoAll(t, func(pkg.String, ...pkg.String) (r0 pkg.Int, r1 error) { return })
 }
 
@@ -933,114 +1025,78 @@ func (_recv *M0) _NamedMixedTwoResults_Return(r0 pkg.Int, r1 error) {
 	_recv._NamedMixedTwoResults_Do(func(pkg.String, ...pkg.String) (pkg.Int, error) { return r0, r1 })
 }
 
-func (M0) _NamedMixedTwoResults_ReturnAll(t *testing.T, r0 pkg.Int, r1 error) {
+func (_recv *M0) _NamedMixedTwoResults_ReturnT(t testing.TB, r0 pkg.Int, r1 error) {
+	_recv._NamedMixedTwoResults_DoT(t, func(pkg.String, ...pkg.String) (pkg.Int, error) { return r0, r1 })
+}
+
+func (M0) _NamedMixedTwoResults_ReturnAll(t testing.TB, r0 pkg.Int, r1 error) {
+	t.Helper()
 	new(M0)._NamedMixedTwoResults_DoAll(t, func(pkg.String, ...pkg.String) (pkg.Int, error) { return r0, r1 })
 }
 
 func (_recv *M0) _NamedMixedTwoResults_Calls() []_M0_NamedMixedTwoResults_Call {
-	if _recv == nil {
-		panic("M0.NamedMixedTwoResults: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.NamedMixedTwoResultsCalls
+	return mock.Calls(_M0Instance(_recv, "M0.NamedMixedTwoResults"), (*_M0Data)._NamedMixedTwoResults)
 }
 
 func (M0) _NamedMixedTwoResults_AllCalls() []_M0_NamedMixedTwoResults_Call {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.NamedMixedTwoResultsCalls
+	return mock.Calls(_M0.Global(), (*_M0Data)._NamedMixedTwoResults)
+}
+
+func (M0) _NamedMixedTwoResults_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M0.NamedMixedTwoResults")
+	mock.Bubble(t, _M0.Global(), func(_dat *_M0Data) { _dat.NamedMixedTwoResults.Calls.Clear() }, &_M0Policy.NamedMixedTwoResults)
 }
 
-func (M0) _NamedMixedTwoResults_BubbleCalls(t *testing.T) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	_dat.NamedMixedTwoResultsCalls = []_M0_NamedMixedTwoResults_Call{}
-	t.Cleanup(func() {
-		defer _dat.mutex.Unlock()
-		_dat.mutex.Lock()
-		_dat.NamedMixedTwoResultsCalls = []_M0_NamedMixedTwoResults_Call{}
-	})
+func (M0) _NamedMixedTwoResults_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M0.NamedMixedTwoResults")
+	_M0Policy.NamedMixedTwoResults.Record(t, limit)
+}
+
+func (_dat *_M0Data) _NamedParamNoResult() *mock.Method[func(pkg.String), _M0_NamedParamNoResult_Call] {
+	return &_dat.NamedParamNoResult
 }
 
 func (_recv *M0) NamedParamNoResult(x pkg.String) {
 	if _recv == nil {
 		panic("M0.NamedParamNoResult: nil pointer receiver")
 	}
-	_dat := _M0PtrData(_recv)
-	_dat.mutex.Lock()
-	_dat.NamedParamNoResultCalls = append(_dat.NamedParamNoResultCalls, _M0_NamedParamNoResult_Call{x})
-	_all := _M0PtrData(nil)
-	_all.mutex.Lock()
-	_all.NamedParamNoResultCalls = append(_all.NamedParamNoResultCalls, _M0_NamedParamNoResult_Call{x})
-	var _fn func(pkg.String)
-	if len(_dat.NamedParamNoResultMocks) > 0 {
-		_fn = _dat.NamedParamNoResultMocks[0]
-		if len(_dat.NamedParamNoResultMocks) > 1 {
-			_dat.NamedParamNoResultMocks = _dat.NamedParamNoResultMocks[1:]
-		}
-	} else if len(_all.NamedParamNoResultMocks) > 0 {
-		_fn = _all.NamedParamNoResultMocks[0]
-		if len(_all.NamedParamNoResultMocks) > 1 {
-			_all.NamedParamNoResultMocks = _all.NamedParamNoResultMocks[1:]
-		}
-	} else {
+	if _M0.Idle(&_M0Policy.NamedParamNoResult) {
+		_recv.T0.NamedParamNoResult(x)
+		return
+	}
+	_fn, _ok := mock.Next(_M0Entry(_recv), _M0.Global(), (*_M0Data)._NamedParamNoResult, &_M0Policy.NamedParamNoResult, _M0_NamedParamNoResult_Call{x})
+	if !_ok {
 		_fn = _recv.T0.NamedParamNoResult
 	}
-	_dat.mutex.Unlock()
-	_all.mutex.Unlock()
 	_fn(x)
 }
 
 func (_recv *M0) _NamedParamNoResult_Do(fn func(pkg.String)) {
-	if _recv == nil {
-		panic("M0.NamedParamNoResult: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.NamedParamNoResultMocks = []func(pkg.String){}
-	} else if len(_dat.NamedParamNoResultMocks) < 2 {
-		_dat.NamedParamNoResultMocks = []func(pkg.String){fn, fn}
-	} else {
-		_dat.NamedParamNoResultMocks = _dat.NamedParamNoResultMocks[:len(_dat.NamedParamNoResultMocks)-1]
-		_dat.NamedParamNoResultMocks = append(_dat.NamedParamNoResultMocks, fn)
-		_dat.NamedParamNoResultMocks = append(_dat.NamedParamNoResultMocks, fn)
-	}
-}
-
-func (M0) _NamedParamNoResult_DoAll(t *testing.T, fn func(pkg.String)) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.NamedParamNoResultMocks = []func(pkg.String){}
-	} else if len(_dat.NamedParamNoResultMocks) < 2 {
-		_dat.NamedParamNoResultMocks = []func(pkg.String){fn, fn}
-	} else {
-		_dat.NamedParamNoResultMocks = _dat.NamedParamNoResultMocks[:len(_dat.NamedParamNoResultMocks)-1]
-		_dat.NamedParamNoResultMocks = append(_dat.NamedParamNoResultMocks, fn)
-		_dat.NamedParamNoResultMocks = append(_dat.NamedParamNoResultMocks, fn)
-	}
-	_dat.once.Do(func() {
-		t.Cleanup(func() {
-			defer _dat.mutex.Unlock()
-			_dat.mutex.Lock()
-			_dat.NamedParamNoResultMocks = []func(pkg.String){}
-			_dat.once = sync.Once{}
-		})
-	})
+	mock.Do(_M0Instance(_recv, "M0.NamedParamNoResult"), (*_M0Data)._NamedParamNoResult, fn)
+}
+
+func (_recv *M0) _NamedParamNoResult_DoT(t testing.TB, fn func(pkg.String)) {
+	mock.DoT(t, _M0Instance(_recv, "M0.NamedParamNoResult"), (*_M0Data)._NamedParamNoResult, fn)
+}
+
+func (M0) _NamedParamNoResult_DoAll(t testing.TB, fn func(pkg.String)) {
+	t.Helper()
+	mock.Serial(t, "M0.NamedParamNoResult")
+	mock.DoAll(t, _M0.Global(), (*_M0Data)._NamedParamNoResult, fn)
 }
 
 func (_recv *M0) _NamedParamNoResult_Stub() {
 	_recv._NamedParamNoResult_Do(func(pkg.String) { return })
 }
 
-func (M0) _NamedParamNoResult_StubAll(t *testing.T) {
+func (_recv *M0) _NamedParamNoResult_StubT(t testing.TB) {
+	_recv._NamedParamNoResult_DoT(t, func(pkg.String) { return })
+}
+
+func (M0) _NamedParamNoResult_StubAll(t testing.TB) {
+	t.Helper()
 	new(M0)._NamedParamNoResult_DoAll(t, func(pkg.String) { return })
 }
 
@@ -1048,114 +1104,77 @@ func (_recv *M0) _NamedParamNoResult_Return() {
 	_recv._NamedParamNoResult_Do(func(pkg.String) { return })
 }
 
-func (M0) _NamedParamNoResult_ReturnAll(t *testing.T) {
+func (_recv *M0) _NamedParamNoResult_ReturnT(t testing.TB) {
+	_recv._NamedParamNoResult_DoT(t, func(pkg.String) { return })
+}
+
+func (M0) _NamedParamNoResult_ReturnAll(t testing.TB) {
+	t.Helper()
 	new(M0)._NamedParamNoResult_DoAll(t, func(pkg.String) { return })
 }
 
 func (_recv *M0) _NamedParamNoResult_Calls() []_M0_NamedParamNoResult_Call {
-	if _recv == nil {
-		panic("M0.NamedParamNoResult: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.NamedParamNoResultCalls
+	return mock.Calls(_M0Instance(_recv, "M0.NamedParamNoResult"), (*_M0Data)._NamedParamNoResult)
 }
 
 func (M0) _NamedParamNoResult_AllCalls() []_M0_NamedParamNoResult_Call {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.NamedParamNoResultCalls
+	return mock.Calls(_M0.Global(), (*_M0Data)._NamedParamNoResult)
+}
+
+func (M0) _NamedParamNoResult_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M0.NamedParamNoResult")
+	mock.Bubble(t, _M0.Global(), func(_dat *_M0Data) { _dat.NamedParamNoResult.Calls.Clear() }, &_M0Policy.NamedParamNoResult)
+}
+
+func (M0) _NamedParamNoResult_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M0.NamedParamNoResult")
+	_M0Policy.NamedParamNoResult.Record(t, limit)
 }
 
-func (M0) _NamedParamNoResult_BubbleCalls(t *testing.T) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	_dat.NamedParamNoResultCalls = []_M0_NamedParamNoResult_Call{}
-	t.Cleanup(func() {
-		defer _dat.mutex.Unlock()
-		_dat.mutex.Lock()
-		_dat.NamedParamNoResultCalls = []_M0_NamedParamNoResult_Call{}
-	})
+func (_dat *_M0Data) _NamedParamOneResult() *mock.Method[func(pkg.String) error, _M0_NamedParamOneResult_Call] {
+	return &_dat.NamedParamOneResult
 }
 
 func (_recv *M0) NamedParamOneResult(x pkg.String) error {
 	if _recv == nil {
 		panic("M0.NamedParamOneResult: nil pointer receiver")
 	}
-	_dat := _M0PtrData(_recv)
-	_dat.mutex.Lock()
-	_dat.NamedParamOneResultCalls = append(_dat.NamedParamOneResultCalls, _M0_NamedParamOneResult_Call{x})
-	_all := _M0PtrData(nil)
-	_all.mutex.Lock()
-	_all.NamedParamOneResultCalls = append(_all.NamedParamOneResultCalls, _M0_NamedParamOneResult_Call{x})
-	var _fn func(pkg.String) error
-	if len(_dat.NamedParamOneResultMocks) > 0 {
-		_fn = _dat.NamedParamOneResultMocks[0]
-		if len(_dat.NamedParamOneResultMocks) > 1 {
-			_dat.NamedParamOneResultMocks = _dat.NamedParamOneResultMocks[1:]
-		}
-	} else if len(_all.NamedParamOneResultMocks) > 0 {
-		_fn = _all.NamedParamOneResultMocks[0]
-		if len(_all.NamedParamOneResultMocks) > 1 {
-			_all.NamedParamOneResultMocks = _all.NamedParamOneResultMocks[1:]
-		}
-	} else {
+	if _M0.Idle(&_M0Policy.NamedParamOneResult) {
+		return _recv.T0.NamedParamOneResult(x)
+	}
+	_fn, _ok := mock.Next(_M0Entry(_recv), _M0.Global(), (*_M0Data)._NamedParamOneResult, &_M0Policy.NamedParamOneResult, _M0_NamedParamOneResult_Call{x})
+	if !_ok {
 		_fn = _recv.T0.NamedParamOneResult
 	}
-	_dat.mutex.Unlock()
-	_all.mutex.Unlock()
 	return _fn(x)
 }
 
 func (_recv *M0) _NamedParamOneResult_Do(fn func(pkg.String) error) {
-	if _recv == nil {
-		panic("M0.NamedParamOneResult: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.NamedParamOneResultMocks = []func(pkg.String) error{}
-	} else if len(_dat.NamedParamOneResultMocks) < 2 {
-		_dat.NamedParamOneResultMocks = []func(pkg.String) error{fn, fn}
-	} else {
-		_dat.NamedParamOneResultMocks = _dat.NamedParamOneResultMocks[:len(_dat.NamedParamOneResultMocks)-1]
-		_dat.NamedParamOneResultMocks = append(_dat.NamedParamOneResultMocks, fn)
-		_dat.NamedParamOneResultMocks = append(_dat.NamedParamOneResultMocks, fn)
-	}
-}
-
-func (M0) _NamedParamOneResult_DoAll(t *testing.T, fn func(pkg.String) error) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.NamedParamOneResultMocks = []func(pkg.String) error{}
-	} else if len(_dat.NamedParamOneResultMocks) < 2 {
-		_dat.NamedParamOneResultMocks = []func(pkg.String) error{fn, fn}
-	} else {
-		_dat.NamedParamOneResultMocks = _dat.NamedParamOneResultMocks[:len(_dat.NamedParamOneResultMocks)-1]
-		_dat.NamedParamOneResultMocks = append(_dat.NamedParamOneResultMocks, fn)
-		_dat.NamedParamOneResultMocks = append(_dat.NamedParamOneResultMocks, fn)
-	}
-	_dat.once.Do(func() {
-		t.Cleanup(func() {
-			defer _dat.mutex.Unlock()
-			_dat.mutex.Lock()
-			_dat.NamedParamOneResultMocks = []func(pkg.String) error{}
-			_dat.once = sync.Once{}
-		})
-	})
+	mock.Do(_M0Instance(_recv, "M0.NamedParamOneResult"), (*_M0Data)._NamedParamOneResult, fn)
+}
+
+func (_recv *M0) _NamedParamOneResult_DoT(t testing.TB, fn func(pkg.String) error) {
+	mock.DoT(t, _M0Instance(_recv, "M0.NamedParamOneResult"), (*_M0Data)._NamedParamOneResult, fn)
+}
+
+func (M0) _NamedParamOneResult_DoAll(t testing.TB, fn func(pkg.String) error) {
+	t.Helper()
+	mock.Serial(t, "M0.NamedParamOneResult")
+	mock.DoAll(t, _M0.Global(), (*_M0Data)._NamedParamOneResult, fn)
 }
 
 func (_recv *M0) _NamedParamOneResult_Stub() {
 	_recv._NamedParamOneResult_Do(func(pkg.String) (r0 error) { return })
 }
 
-func (M0) _NamedParamOneResult_StubAll(t *testing.T) {
+func (_recv *M0) _NamedParamOneResult_StubT(t testing.TB) {
+	_recv._NamedParamOneResult_DoT(t, func(pkg.String) (r0 error) { return })
+}
+
+func (M0) _NamedParamOneResult_StubAll(t testing.TB) {
+	t.Helper()
 	new(M0)._NamedParamOneResult_DoAll(t, func(pkg.String) (r0 error) { return })
 }
 
@@ -1163,114 +1182,77 @@ func (_recv *M0) _NamedParamOneResult_Return(r0 error) {
 	_recv._NamedParamOneResult_Do(func(pkg.String) error { return r0 })
 }
 
-func (M0) _NamedParamOneResult_ReturnAll(t *testing.T, r0 error) {
+func (_recv *M0) _NamedParamOneResult_ReturnT(t testing.TB, r0 error) {
+	_recv._NamedParamOneResult_DoT(t, func(pkg.String) error { return r0 })
+}
+
+func (M0) _NamedParamOneResult_ReturnAll(t testing.TB, r0 error) {
+	t.Helper()
 	new(M0)._NamedParamOneResult_DoAll(t, func(pkg.String) error { return r0 })
 }
 
 func (_recv *M0) _NamedParamOneResult_Calls() []_M0_NamedParamOneResult_Call {
-	if _recv == nil {
-		panic("M0.NamedParamOneResult: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.NamedParamOneResultCalls
+	return mock.Calls(_M0Instance(_recv, "M0.NamedParamOneResult"), (*_M0Data)._NamedParamOneResult)
 }
 
 func (M0) _NamedParamOneResult_AllCalls() []_M0_NamedParamOneResult_Call {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.NamedParamOneResultCalls
+	return mock.Calls(_M0.Global(), (*_M0Data)._NamedParamOneResult)
+}
+
+func (M0) _NamedParamOneResult_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M0.NamedParamOneResult")
+	mock.Bubble(t, _M0.Global(), func(_dat *_M0Data) { _dat.NamedParamOneResult.Calls.Clear() }, &_M0Policy.NamedParamOneResult)
+}
+
+func (M0) _NamedParamOneResult_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M0.NamedParamOneResult")
+	_M0Policy.NamedParamOneResult.Record(t, limit)
 }
 
-func (M0) _NamedParamOneResult_BubbleCalls(t *testing.T) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	_dat.NamedParamOneResultCalls = []_M0_NamedParamOneResult_Call{}
-	t.Cleanup(func() {
-		defer _dat.mutex.Unlock()
-		_dat.mutex.Lock()
-		_dat.NamedParamOneResultCalls = []_M0_NamedParamOneResult_Call{}
-	})
+func (_dat *_M0Data) _NamedParamTwoResults() *mock.Method[func(pkg.String) (pkg.Int, error), _M0_NamedParamTwoResults_Call] {
+	return &_dat.NamedParamTwoResults
 }
 
 func (_recv *M0) NamedParamTwoResults(x pkg.String) (pkg.Int, error) {
 	if _recv == nil {
 		panic("M0.NamedParamTwoResults: nil pointer receiver")
 	}
-	_dat := _M0PtrData(_recv)
-	_dat.mutex.Lock()
-	_dat.NamedParamTwoResultsCalls = append(_dat.NamedParamTwoResultsCalls, _M0_NamedParamTwoResults_Call{x})
-	_all := _M0PtrData(nil)
-	_all.mutex.Lock()
-	_all.NamedParamTwoResultsCalls = append(_all.NamedParamTwoResultsCalls, _M0_NamedParamTwoResults_Call{x})
-	var _fn func(pkg.String) (pkg.Int, error)
-	if len(_dat.NamedParamTwoResultsMocks) > 0 {
-		_fn = _dat.NamedParamTwoResultsMocks[0]
-		if len(_dat.NamedParamTwoResultsMocks) > 1 {
-			_dat.NamedParamTwoResultsMocks = _dat.NamedParamTwoResultsMocks[1:]
-		}
-	} else if len(_all.NamedParamTwoResultsMocks) > 0 {
-		_fn = _all.NamedParamTwoResultsMocks[0]
-		if len(_all.NamedParamTwoResultsMocks) > 1 {
-			_all.NamedParamTwoResultsMocks = _all.NamedParamTwoResultsMocks[1:]
-		}
-	} else {
+	if _M0.Idle(&_M0Policy.NamedParamTwoResults) {
+		return _recv.T0.NamedParamTwoResults(x)
+	}
+	_fn, _ok := mock.Next(_M0Entry(_recv), _M0.Global(), (*_M0Data)._NamedParamTwoResults, &_M0Policy.NamedParamTwoResults, _M0_NamedParamTwoResults_Call{x})
+	if !_ok {
 		_fn = _recv.T0.NamedParamTwoResults
 	}
-	_dat.mutex.Unlock()
-	_all.mutex.Unlock()
 	return _fn(x)
 }
 
 func (_recv *M0) _NamedParamTwoResults_Do(fn func(pkg.String) (pkg.Int, error)) {
-	if _recv == nil {
-		panic("M0.NamedParamTwoResults: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.NamedParamTwoResultsMocks = []func(pkg.String) (pkg.Int, error){}
-	} else if len(_dat.NamedParamTwoResultsMocks) < 2 {
-		_dat.NamedParamTwoResultsMocks = []func(pkg.String) (pkg.Int, error){fn, fn}
-	} else {
-		_dat.NamedParamTwoResultsMocks = _dat.NamedParamTwoResultsMocks[:len(_dat.NamedParamTwoResultsMocks)-1]
-		_dat.NamedParamTwoResultsMocks = append(_dat.NamedParamTwoResultsMocks, fn)
-		_dat.NamedParamTwoResultsMocks = append(_dat.NamedParamTwoResultsMocks, fn)
-	}
-}
-
-func (M0) _NamedParamTwoResults_DoAll(t *testing.T, fn func(pkg.String) (pkg.Int, error)) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.NamedParamTwoResultsMocks = []func(pkg.String) (pkg.Int, error){}
-	} else if len(_dat.NamedParamTwoResultsMocks) < 2 {
-		_dat.NamedParamTwoResultsMocks = []func(pkg.String) (pkg.Int, error){fn, fn}
-	} else {
-		_dat.NamedParamTwoResultsMocks = _dat.NamedParamTwoResultsMocks[:len(_dat.NamedParamTwoResultsMocks)-1]
-		_dat.NamedParamTwoResultsMocks = append(_dat.NamedParamTwoResultsMocks, fn)
-		_dat.NamedParamTwoResultsMocks = append(_dat.NamedParamTwoResultsMocks, fn)
-	}
-	_dat.once.Do(func() {
-		t.Cleanup(func() {
-			defer _dat.mutex.Unlock()
-			_dat.mutex.Lock()
-			_dat.NamedParamTwoResultsMocks = []func(pkg.String) (pkg.Int, error){}
-			_dat.once = sync.Once{}
-		})
-	})
+	mock.Do(_M0Instance(_recv, "M0.NamedParamTwoResults"), (*_M0Data)._NamedParamTwoResults, fn)
+}
+
+func (_recv *M0) _NamedParamTwoResults_DoT(t testing.TB, fn func(pkg.String) (pkg.Int, error)) {
+	mock.DoT(t, _M0Instance(_recv, "M0.NamedParamTwoResults"), (*_M0Data)._NamedParamTwoResults, fn)
+}
+
+func (M0) _NamedParamTwoResults_DoAll(t testing.TB, fn func(pkg.String) (pkg.Int, error)) {
+	t.Helper()
+	mock.Serial(t, "M0.NamedParamTwoResults")
+	mock.DoAll(t, _M0.Global(), (*_M0Data)._NamedParamTwoResults, fn)
 }
 
 func (_recv *M0) _NamedParamTwoResults_Stub() {
 	_recv._NamedParamTwoResults_Do(func(pkg.String) (r0 pkg.Int, r1 error) { return })
 }
 
-func (M0) _NamedParamTwoResults_StubAll(t *testing.T) {
+func (_recv *M0) _NamedParamTwoResults_StubT(t testing.TB) {
+	_recv._NamedParamTwoResults_DoT(t, func(pkg.String) (r0 pkg.Int, r1 error) { return })
+}
+
+func (M0) _NamedParamTwoResults_StubAll(t testing.TB) {
+	t.Helper()
 	new(M0)._NamedParamTwoResults_DoAll(t, func(pkg.String) (r0 pkg.Int, r1 error) { return })
 }
 
@@ -1278,114 +1260,77 @@ func (_recv *M0) _NamedParamTwoResults_Return(r0 pkg.Int, r1 error) {
 	_recv._NamedParamTwoResults_Do(func(pkg.String) (pkg.Int, error) { return r0, r1 })
 }
 
-func (M0) _NamedParamTwoResults_ReturnAll(t *testing.T, r0 pkg.Int, r1 error) {
+func (_recv *M0) _NamedParamTwoResults_ReturnT(t testing.TB, r0 pkg.Int, r1 error) {
+	_recv._NamedParamTwoResults_DoT(t, func(pkg.String) (pkg.Int, error) { return r0, r1 })
+}
+
+func (M0) _NamedParamTwoResults_ReturnAll(t testing.TB, r0 pkg.Int, r1 error) {
+	t.Helper()
 	new(M0)._NamedParamTwoResults_DoAll(t, func(pkg.String) (pkg.Int, error) { return r0, r1 })
 }
 
 func (_recv *M0) _NamedParamTwoResults_Calls() []_M0_NamedParamTwoResults_Call {
-	if _recv == nil {
-		panic("M0.NamedParamTwoResults: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.NamedParamTwoResultsCalls
+	return mock.Calls(_M0Instance(_recv, "M0.NamedParamTwoResults"), (*_M0Data)._NamedParamTwoResults)
 }
 
 func (M0) _NamedParamTwoResults_AllCalls() []_M0_NamedParamTwoResults_Call {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.NamedParamTwoResultsCalls
+	return mock.Calls(_M0.Global(), (*_M0Data)._NamedParamTwoResults)
+}
+
+func (M0) _NamedParamTwoResults_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M0.NamedParamTwoResults")
+	mock.Bubble(t, _M0.Global(), func(_dat *_M0Data) { _dat.NamedParamTwoResults.Calls.Clear() }, &_M0Policy.NamedParamTwoResults)
+}
+
+func (M0) _NamedParamTwoResults_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M0.NamedParamTwoResults")
+	_M0Policy.NamedParamTwoResults.Record(t, limit)
 }
 
-func (M0) _NamedParamTwoResults_BubbleCalls(t *testing.T) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	_dat.NamedParamTwoResultsCalls = []_M0_NamedParamTwoResults_Call{}
-	t.Cleanup(func() {
-		defer _dat.mutex.Unlock()
-		_dat.mutex.Lock()
-		_dat.NamedParamTwoResultsCalls = []_M0_NamedParamTwoResults_Call{}
-	})
+func (_dat *_M0Data) _OneNamedResult() *mock.Method[func() error, _M0_OneNamedResult_Call] {
+	return &_dat.OneNamedResult
 }
 
 func (_recv *M0) OneNamedResult() error {
 	if _recv == nil {
 		panic("M0.OneNamedResult: nil pointer receiver")
 	}
-	_dat := _M0PtrData(_recv)
-	_dat.mutex.Lock()
-	_dat.OneNamedResultCalls = append(_dat.OneNamedResultCalls, _M0_OneNamedResult_Call{})
-	_all := _M0PtrData(nil)
-	_all.mutex.Lock()
-	_all.OneNamedResultCalls = append(_all.OneNamedResultCalls, _M0_OneNamedResult_Call{})
-	var _fn func() error
-	if len(_dat.OneNamedResultMocks) > 0 {
-		_fn = _dat.OneNamedResultMocks[0]
-		if len(_dat.OneNamedResultMocks) > 1 {
-			_dat.OneNamedResultMocks = _dat.OneNamedResultMocks[1:]
-		}
-	} else if len(_all.OneNamedResultMocks) > 0 {
-		_fn = _all.OneNamedResultMocks[0]
-		if len(_all.OneNamedResultMocks) > 1 {
-			_all.OneNamedResultMocks = _all.OneNamedResultMocks[1:]
-		}
-	} else {
+	if _M0.Idle(&_M0Policy.OneNamedResult) {
+		return _recv.T0.OneNamedResult()
+	}
+	_fn, _ok := mock.Next(_M0Entry(_recv), _M0.Global(), (*_M0Data)._OneNamedResult, &_M0Policy.OneNamedResult, _M0_OneNamedResult_Call{})
+	if !_ok {
 		_fn = _recv.T0.OneNamedResult
 	}
-	_dat.mutex.Unlock()
-	_all.mutex.Unlock()
 	return _fn()
 }
 
 func (_recv *M0) _OneNamedResult_Do(fn func() error) {
-	if _recv == nil {
-		panic("M0.OneNamedResult: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.OneNamedResultMocks = []func() error{}
-	} else if len(_dat.OneNamedResultMocks) < 2 {
-		_dat.OneNamedResultMocks = []func() error{fn, fn}
-	} else {
-		_dat.OneNamedResultMocks = _dat.OneNamedResultMocks[:len(_dat.OneNamedResultMocks)-1]
-		_dat.OneNamedResultMocks = append(_dat.OneNamedResultMocks, fn)
-		_dat.OneNamedResultMocks = append(_dat.OneNamedResultMocks, fn)
-	}
-}
-
-func (M0) _OneNamedResult_DoAll(t *testing.T, fn func() error) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.OneNamedResultMocks = []func() error{}
-	} else if len(_dat.OneNamedResultMocks) < 2 {
-		_dat.OneNamedResultMocks = []func() error{fn, fn}
-	} else {
-		_dat.OneNamedResultMocks = _dat.OneNamedResultMocks[:len(_dat.OneNamedResultMocks)-1]
-		_dat.OneNamedResultMocks = append(_dat.OneNamedResultMocks, fn)
-		_dat.OneNamedResultMocks = append(_dat.OneNamedResultMocks, fn)
-	}
-	_dat.once.Do(func() {
-		t.Cleanup(func() {
-			defer _dat.mutex.Unlock()
-			_dat.mutex.Lock()
-			_dat.OneNamedResultMocks = []func() error{}
-			_dat.once = sync.Once{}
-		})
-	})
+	mock.Do(_M0Instance(_recv, "M0.OneNamedResult"), (*_M0Data)._OneNamedResult, fn)
+}
+
+func (_recv *M0) _OneNamedResult_DoT(t testing.TB, fn func() error) {
+	mock.DoT(t, _M0Instance(_recv, "M0.OneNamedResult"), (*_M0Data)._OneNamedResult, fn)
+}
+
+func (M0) _OneNamedResult_DoAll(t testing.TB, fn func() error) {
+	t.Helper()
+	mock.Serial(t, "M0.OneNamedResult")
+	mock.DoAll(t, _M0.Global(), (*_M0Data)._OneNamedResult, fn)
 }
 
 func (_recv *M0) _OneNamedResult_Stub() {
 	_recv._OneNamedResult_Do(func() (err error) { return })
 }
 
-func (M0) _OneNamedResult_StubAll(t *testing.T) {
+func (_recv *M0) _OneNamedResult_StubT(t testing.TB) {
+	_recv._OneNamedResult_DoT(t, func() (err error) { return })
+}
+
+func (M0) _OneNamedResult_StubAll(t testing.TB) {
+	t.Helper()
 	new(M0)._OneNamedResult_DoAll(t, func() (err error) { return })
 }
 
@@ -1393,114 +1338,78 @@ func (_recv *M0) _OneNamedResult_Return(err error) {
 	_recv._OneNamedResult_Do(func() error { return err })
 }
 
-func (M0) _OneNamedResult_ReturnAll(t *testing.T, err error) {
+func (_recv *M0) _OneNamedResult_ReturnT(t testing.TB, err error) {
+	_recv._OneNamedResult_DoT(t, func() error { return err })
+}
+
+func (M0) _OneNamedResult_ReturnAll(t testing.TB, err error) {
+	t.Helper()
 	new(M0)._OneNamedResult_DoAll(t, func() error { return err })
 }
 
 func (_recv *M0) _OneNamedResult_Calls() []_M0_OneNamedResult_Call {
-	if _recv == nil {
-		panic("M0.OneNamedResult: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.OneNamedResultCalls
+	return mock.Calls(_M0Instance(_recv, "M0.OneNamedResult"), (*_M0Data)._OneNamedResult)
 }
 
 func (M0) _OneNamedResult_AllCalls() []_M0_OneNamedResult_Call {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.OneNamedResultCalls
+	return mock.Calls(_M0.Global(), (*_M0Data)._OneNamedResult)
+}
+
+func (M0) _OneNamedResult_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M0.OneNamedResult")
+	mock.Bubble(t, _M0.Global(), func(_dat *_M0Data) { _dat.OneNamedResult.Calls.Clear() }, &_M0Policy.OneNamedResult)
 }
 
-func (M0) _OneNamedResult_BubbleCalls(t *testing.T) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	_dat.OneNamedResultCalls = []_M0_OneNamedResult_Call{}
-	t.Cleanup(func() {
-		defer _dat.mutex.Unlock()
-		_dat.mutex.Lock()
-		_dat.OneNamedResultCalls = []_M0_OneNamedResult_Call{}
-	})
+func (M0) _OneNamedResult_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M0.OneNamedResult")
+	_M0Policy.OneNamedResult.Record(t, limit)
+}
+
+func (_dat *_M0Data) _OneParamNoResult() *mock.Method[func(pkg.String), _M0_OneParamNoResult_Call] {
+	return &_dat.OneParamNoResult
 }
 
 func (_recv *M0) OneParamNoResult(P0 pkg.String) {
 	if _recv == nil {
 		panic("M0.OneParamNoResult: nil pointer receiver")
 	}
-	_dat := _M0PtrData(_recv)
-	_dat.mutex.Lock()
-	_dat.OneParamNoResultCalls = append(_dat.OneParamNoResultCalls, _M0_OneParamNoResult_Call{P0})
-	_all := _M0PtrData(nil)
-	_all.mutex.Lock()
-	_all.OneParamNoResultCalls = append(_all.OneParamNoResultCalls, _M0_OneParamNoResult_Call{P0})
-	var _fn func(pkg.String)
-	if len(_dat.OneParamNoResultMocks) > 0 {
-		_fn = _dat.OneParamNoResultMocks[0]
-		if len(_dat.OneParamNoResultMocks) > 1 {
-			_dat.OneParamNoResultMocks = _dat.OneParamNoResultMocks[1:]
-		}
-	} else if len(_all.OneParamNoResultMocks) > 0 {
-		_fn = _all.OneParamNoResultMocks[0]
-		if len(_all.OneParamNoResultMocks) > 1 {
-			_all.OneParamNoResultMocks = _all.OneParamNoResultMocks[1:]
-		}
-	} else {
+	if _M0.Idle(&_M0Policy.OneParamNoResult) {
+		_recv.T0.OneParamNoResult(P0)
+		return
+	}
+	_fn, _ok := mock.Next(_M0Entry(_recv), _M0.Global(), (*_M0Data)._OneParamNoResult, &_M0Policy.OneParamNoResult, _M0_OneParamNoResult_Call{P0})
+	if !_ok {
 		_fn = _recv.T0.OneParamNoResult
 	}
-	_dat.mutex.Unlock()
-	_all.mutex.Unlock()
 	_fn(P0)
 }
 
 func (_recv *M0) _OneParamNoResult_Do(fn func(pkg.String)) {
-	if _recv == nil {
-		panic("M0.OneParamNoResult: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.OneParamNoResultMocks = []func(pkg.String){}
-	} else if len(_dat.OneParamNoResultMocks) < 2 {
-		_dat.OneParamNoResultMocks = []func(pkg.String){fn, fn}
-	} else {
-		_dat.OneParamNoResultMocks = _dat.OneParamNoResultMocks[:len(_dat.OneParamNoResultMocks)-1]
-		_dat.OneParamNoResultMocks = append(_dat.OneParamNoResultMocks, fn)
-		_dat.OneParamNoResultMocks = append(_dat.OneParamNoResultMocks, fn)
-	}
-}
-
-func (M0) _OneParamNoResult_DoAll(t *testing.T, fn func(pkg.String)) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.OneParamNoResultMocks = []func(pkg.String){}
-	} else if len(_dat.OneParamNoResultMocks) < 2 {
-		_dat.OneParamNoResultMocks = []func(pkg.String){fn, fn}
-	} else {
-		_dat.OneParamNoResultMocks = _dat.OneParamNoResultMocks[:len(_dat.OneParamNoResultMocks)-1]
-		_dat.OneParamNoResultMocks = append(_dat.OneParamNoResultMocks, fn)
-		_dat.OneParamNoResultMocks = append(_dat.OneParamNoResultMocks, fn)
-	}
-	_dat.once.Do(func() {
-		t.Cleanup(func() {
-			defer _dat.mutex.Unlock()
-			_dat.mutex.Lock()
-			_dat.OneParamNoResultMocks = []func(pkg.String){}
-			_dat.once = sync.Once{}
-		})
-	})
+	mock.Do(_M0Instance(_recv, "M0.OneParamNoResult"), (*_M0Data)._OneParamNoResult, fn)
+}
+
+func (_recv *M0) _OneParamNoResult_DoT(t testing.TB, fn func(pkg.String)) {
+	mock.DoT(t, _M0Instance(_recv, "M0.OneParamNoResult"), (*_M0Data)._OneParamNoResult, fn)
+}
+
+func (M0) _OneParamNoResult_DoAll(t testing.TB, fn func(pkg.String)) {
+	t.Helper()
+	mock.Serial(t, "M0.OneParamNoResult")
+	mock.DoAll(t, _M0.Global(), (*_M0Data)._OneParamNoResult, fn)
 }
 
 func (_recv *M0) _OneParamNoResult_Stub() {
 	_recv._OneParamNoResult_Do(func(pkg.String) { return })
 }
 
-func (M0) _OneParamNoResult_StubAll(t *testing.T) {
+func (_recv *M0) _OneParamNoResult_StubT(t testing.TB) {
+	_recv._OneParamNoResult_DoT(t, func(pkg.String) { return })
+}
+
+func (M0) _OneParamNoResult_StubAll(t testing.TB) {
+	t.Helper()
 	new(M0)._OneParamNoResult_DoAll(t, func(pkg.String) { return })
 }
 
@@ -1508,114 +1417,77 @@ func (_recv *M0) _OneParamNoResult_Return() {
 	_recv._OneParamNoResult_Do(func(pkg.String) { return })
 }
 
-func (M0) _OneParamNoResult_ReturnAll(t *testing.T) {
+func (_recv *M0) _OneParamNoResult_ReturnT(t testing.TB) {
+	_recv._OneParamNoResult_DoT(t, func(pkg.String) { return })
+}
+
+func (M0) _OneParamNoResult_ReturnAll(t testing.TB) {
+	t.Helper()
 	new(M0)._OneParamNoResult_DoAll(t, func(pkg.String) { return })
 }
 
 func (_recv *M0) _OneParamNoResult_Calls() []_M0_OneParamNoResult_Call {
-	if _recv == nil {
-		panic("M0.OneParamNoResult: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.OneParamNoResultCalls
+	return mock.Calls(_M0Instance(_recv, "M0.OneParamNoResult"), (*_M0Data)._OneParamNoResult)
 }
 
 func (M0) _OneParamNoResult_AllCalls() []_M0_OneParamNoResult_Call {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.OneParamNoResultCalls
+	return mock.Calls(_M0.Global(), (*_M0Data)._OneParamNoResult)
+}
+
+func (M0) _OneParamNoResult_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M0.OneParamNoResult")
+	mock.Bubble(t, _M0.Global(), func(_dat *_M0Data) { _dat.OneParamNoResult.Calls.Clear() }, &_M0Policy.OneParamNoResult)
+}
+
+func (M0) _OneParamNoResult_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M0.OneParamNoResult")
+	_M0Policy.OneParamNoResult.Record(t, limit)
 }
 
-func (M0) _OneParamNoResult_BubbleCalls(t *testing.T) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	_dat.OneParamNoResultCalls = []_M0_OneParamNoResult_Call{}
-	t.Cleanup(func() {
-		defer _dat.mutex.Unlock()
-		_dat.mutex.Lock()
-		_dat.OneParamNoResultCalls = []_M0_OneParamNoResult_Call{}
-	})
+func (_dat *_M0Data) _OneParamOneResult() *mock.Method[func(pkg.String) error, _M0_OneParamOneResult_Call] {
+	return &_dat.OneParamOneResult
 }
 
 func (_recv *M0) OneParamOneResult(P0 pkg.String) error {
 	if _recv == nil {
 		panic("M0.OneParamOneResult: nil pointer receiver")
 	}
-	_dat := _M0PtrData(_recv)
-	_dat.mutex.Lock()
-	_dat.OneParamOneResultCalls = append(_dat.OneParamOneResultCalls, _M0_OneParamOneResult_Call{P0})
-	_all := _M0PtrData(nil)
-	_all.mutex.Lock()
-	_all.OneParamOneResultCalls = append(_all.OneParamOneResultCalls, _M0_OneParamOneResult_Call{P0})
-	var _fn func(pkg.String) error
-	if len(_dat.OneParamOneResultMocks) > 0 {
-		_fn = _dat.OneParamOneResultMocks[0]
-		if len(_dat.OneParamOneResultMocks) > 1 {
-			_dat.OneParamOneResultMocks = _dat.OneParamOneResultMocks[1:]
-		}
-	} else if len(_all.OneParamOneResultMocks) > 0 {
-		_fn = _all.OneParamOneResultMocks[0]
-		if len(_all.OneParamOneResultMocks) > 1 {
-			_all.OneParamOneResultMocks = _all.OneParamOneResultMocks[1:]
-		}
-	} else {
+	if _M0.Idle(&_M0Policy.OneParamOneResult) {
+		return _recv.T0.OneParamOneResult(P0)
+	}
+	_fn, _ok := mock.Next(_M0Entry(_recv), _M0.Global(), (*_M0Data)._OneParamOneResult, &_M0Policy.OneParamOneResult, _M0_OneParamOneResult_Call{P0})
+	if !_ok {
 		_fn = _recv.T0.OneParamOneResult
 	}
-	_dat.mutex.Unlock()
-	_all.mutex.Unlock()
 	return _fn(P0)
 }
 
 func (_recv *M0) _OneParamOneResult_Do(fn func(pkg.String) error) {
-	if _recv == nil {
-		panic("M0.OneParamOneResult: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.OneParamOneResultMocks = []func(pkg.String) error{}
-	} else if len(_dat.OneParamOneResultMocks) < 2 {
-		_dat.OneParamOneResultMocks = []func(pkg.String) error{fn, fn}
-	} else {
-		_dat.OneParamOneResultMocks = _dat.OneParamOneResultMocks[:len(_dat.OneParamOneResultMocks)-1]
-		_dat.OneParamOneResultMocks = append(_dat.OneParamOneResultMocks, fn)
-		_dat.OneParamOneResultMocks = append(_dat.OneParamOneResultMocks, fn)
-	}
-}
-
-func (M0) _OneParamOneResult_DoAll(t *testing.T, fn func(pkg.String) error) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.OneParamOneResultMocks = []func(pkg.String) error{}
-	} else if len(_dat.OneParamOneResultMocks) < 2 {
-		_dat.OneParamOneResultMocks = []func(pkg.String) error{fn, fn}
-	} else {
-		_dat.OneParamOneResultMocks = _dat.OneParamOneResultMocks[:len(_dat.OneParamOneResultMocks)-1]
-		_dat.OneParamOneResultMocks = append(_dat.OneParamOneResultMocks, fn)
-		_dat.OneParamOneResultMocks = append(_dat.OneParamOneResultMocks, fn)
-	}
-	_dat.once.Do(func() {
-		t.Cleanup(func() {
-			defer _dat.mutex.Unlock()
-			_dat.mutex.Lock()
-			_dat.OneParamOneResultMocks = []func(pkg.String) error{}
-			_dat.once = sync.Once{}
-		})
-	})
+	mock.Do(_M0Instance(_recv, "M0.OneParamOneResult"), (*_M0Data)._OneParamOneResult, fn)
+}
+
+func (_recv *M0) _OneParamOneResult_DoT(t testing.TB, fn func(pkg.String) error) {
+	mock.DoT(t, _M0Instance(_recv, "M0.OneParamOneResult"), (*_M0Data)._OneParamOneResult, fn)
+}
+
+func (M0) _OneParamOneResult_DoAll(t testing.TB, fn func(pkg.String) error) {
+	t.Helper()
+	mock.Serial(t, "M0.OneParamOneResult")
+	mock.DoAll(t, _M0.Global(), (*_M0Data)._OneParamOneResult, fn)
 }
 
 func (_recv *M0) _OneParamOneResult_Stub() {
 	_recv._OneParamOneResult_Do(func(pkg.String) (r0 error) { return })
 }
 
-func (M0) _OneParamOneResult_StubAll(t *testing.T) {
+func (_recv *M0) _OneParamOneResult_StubT(t testing.TB) {
+	_recv._OneParamOneResult_DoT(t, func(pkg.String) (r0 error) { return })
+}
+
+func (M0) _OneParamOneResult_StubAll(t testing.TB) {
+	t.Helper()
 	new(M0)._OneParamOneResult_DoAll(t, func(pkg.String) (r0 error) { return })
 }
 
@@ -1623,114 +1495,77 @@ func (_recv *M0) _OneParamOneResult_Return(r0 error) {
 	_recv._OneParamOneResult_Do(func(pkg.String) error { return r0 })
 }
 
-func (M0) _OneParamOneResult_ReturnAll(t *testing.T, r0 error) {
+func (_recv *M0) _OneParamOneResult_ReturnT(t testing.TB, r0 error) {
+	_recv._OneParamOneResult_DoT(t, func(pkg.String) error { return r0 })
+}
+
+func (M0) _OneParamOneResult_ReturnAll(t testing.TB, r0 error) {
+	t.Helper()
 	new(M0)._OneParamOneResult_DoAll(t, func(pkg.String) error { return r0 })
 }
 
 func (_recv *M0) _OneParamOneResult_Calls() []_M0_OneParamOneResult_Call {
-	if _recv == nil {
-		panic("M0.OneParamOneResult: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.OneParamOneResultCalls
+	return mock.Calls(_M0Instance(_recv, "M0.OneParamOneResult"), (*_M0Data)._OneParamOneResult)
 }
 
 func (M0) _OneParamOneResult_AllCalls() []_M0_OneParamOneResult_Call {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.OneParamOneResultCalls
+	return mock.Calls(_M0.Global(), (*_M0Data)._OneParamOneResult)
 }
 
-func (M0) _OneParamOneResult_BubbleCalls(t *testing.T) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	_dat.OneParamOneResultCalls = []_M0_OneParamOneResult_Call{}
-	t.Cleanup(func() {
-		defer _dat.mutex.Unlock()
-		_dat.mutex.Lock()
-		_dat.OneParamOneResultCalls = []_M0_OneParamOneResult_Call{}
-	})
+func (M0) _OneParamOneResult_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M0.OneParamOneResult")
+	mock.Bubble(t, _M0.Global(), func(_dat *_M0Data) { _dat.OneParamOneResult.Calls.Clear() }, &_M0Policy.OneParamOneResult)
+}
+
+func (M0) _OneParamOneResult_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M0.OneParamOneResult")
+	_M0Policy.OneParamOneResult.Record(t, limit)
+}
+
+func (_dat *_M0Data) _OneParamTwoResults() *mock.Method[func(pkg.String) (pkg.Int, error), _M0_OneParamTwoResults_Call] {
+	return &_dat.OneParamTwoResults
 }
 
 func (_recv *M0) OneParamTwoResults(P0 pkg.String) (pkg.Int, error) {
 	if _recv == nil {
 		panic("M0.OneParamTwoResults: nil pointer receiver")
 	}
-	_dat := _M0PtrData(_recv)
-	_dat.mutex.Lock()
-	_dat.OneParamTwoResultsCalls = append(_dat.OneParamTwoResultsCalls, _M0_OneParamTwoResults_Call{P0})
-	_all := _M0PtrData(nil)
-	_all.mutex.Lock()
-	_all.OneParamTwoResultsCalls = append(_all.OneParamTwoResultsCalls, _M0_OneParamTwoResults_Call{P0})
-	var _fn func(pkg.String) (pkg.Int, error)
-	if len(_dat.OneParamTwoResultsMocks) > 0 {
-		_fn = _dat.OneParamTwoResultsMocks[0]
-		if len(_dat.OneParamTwoResultsMocks) > 1 {
-			_dat.OneParamTwoResultsMocks = _dat.OneParamTwoResultsMocks[1:]
-		}
-	} else if len(_all.OneParamTwoResultsMocks) > 0 {
-		_fn = _all.OneParamTwoResultsMocks[0]
-		if len(_all.OneParamTwoResultsMocks) > 1 {
-			_all.OneParamTwoResultsMocks = _all.OneParamTwoResultsMocks[1:]
-		}
-	} else {
+	if _M0.Idle(&_M0Policy.OneParamTwoResults) {
+		return _recv.T0.OneParamTwoResults(P0)
+	}
+	_fn, _ok := mock.Next(_M0Entry(_recv), _M0.Global(), (*_M0Data)._OneParamTwoResults, &_M0Policy.OneParamTwoResults, _M0_OneParamTwoResults_Call{P0})
+	if !_ok {
 		_fn = _recv.T0.OneParamTwoResults
 	}
-	_dat.mutex.Unlock()
-	_all.mutex.Unlock()
 	return _fn(P0)
 }
 
 func (_recv *M0) _OneParamTwoResults_Do(fn func(pkg.String) (pkg.Int, error)) {
-	if _recv == nil {
-		panic("M0.OneParamTwoResults: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.OneParamTwoResultsMocks = []func(pkg.String) (pkg.Int, error){}
-	} else if len(_dat.OneParamTwoResultsMocks) < 2 {
-		_dat.OneParamTwoResultsMocks = []func(pkg.String) (pkg.Int, error){fn, fn}
-	} else {
-		_dat.OneParamTwoResultsMocks = _dat.OneParamTwoResultsMocks[:len(_dat.OneParamTwoResultsMocks)-1]
-		_dat.OneParamTwoResultsMocks = append(_dat.OneParamTwoResultsMocks, fn)
-		_dat.OneParamTwoResultsMocks = append(_dat.OneParamTwoResultsMocks, fn)
-	}
-}
-
-func (M0) _OneParamTwoResults_DoAll(t *testing.T, fn func(pkg.String) (pkg.Int, error)) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.OneParamTwoResultsMocks = []func(pkg.String) (pkg.Int, error){}
-	} else if len(_dat.OneParamTwoResultsMocks) < 2 {
-		_dat.OneParamTwoResultsMocks = []func(pkg.String) (pkg.Int, error){fn, fn}
-	} else {
-		_dat.OneParamTwoResultsMocks = _dat.OneParamTwoResultsMocks[:len(_dat.OneParamTwoResultsMocks)-1]
-		_dat.OneParamTwoResultsMocks = append(_dat.OneParamTwoResultsMocks, fn)
-		_dat.OneParamTwoResultsMocks = append(_dat.OneParamTwoResultsMocks, fn)
-	}
-	_dat.once.Do(func() {
-		t.Cleanup(func() {
-			defer _dat.mutex.Unlock()
-			_dat.mutex.Lock()
-			_dat.OneParamTwoResultsMocks = []func(pkg.String) (pkg.Int, error){}
-			_dat.once = sync.Once{}
-		})
-	})
+	mock.Do(_M0Instance(_recv, "M0.OneParamTwoResults"), (*_M0Data)._OneParamTwoResults, fn)
+}
+
+func (_recv *M0) _OneParamTwoResults_DoT(t testing.TB, fn func(pkg.String) (pkg.Int, error)) {
+	mock.DoT(t, _M0Instance(_recv, "M0.OneParamTwoResults"), (*_M0Data)._OneParamTwoResults, fn)
+}
+
+func (M0) _OneParamTwoResults_DoAll(t testing.TB, fn func(pkg.String) (pkg.Int, error)) {
+	t.Helper()
+	mock.Serial(t, "M0.OneParamTwoResults")
+	mock.DoAll(t, _M0.Global(), (*_M0Data)._OneParamTwoResults, fn)
 }
 
 func (_recv *M0) _OneParamTwoResults_Stub() {
 	_recv._OneParamTwoResults_Do(func(pkg.String) (r0 pkg.Int, r1 error) { return })
 }
 
-func (M0) _OneParamTwoResults_StubAll(t *testing.T) {
+func (_recv *M0) _OneParamTwoResults_StubT(t testing.TB) {
+	_recv._OneParamTwoResults_DoT(t, func(pkg.String) (r0 pkg.Int, r1 error) { return })
+}
+
+func (M0) _OneParamTwoResults_StubAll(t testing.TB) {
+	t.Helper()
 	new(M0)._OneParamTwoResults_DoAll(t, func(pkg.String) (r0 pkg.Int, r1 error) { return })
 }
 
@@ -1738,114 +1573,77 @@ func (_recv *M0) _OneParamTwoResults_Return(r0 pkg.Int, r1 error) {
 	_recv._OneParamTwoResults_Do(func(pkg.String) (pkg.Int, error) { return r0, r1 })
 }
 
-func (M0) _OneParamTwoResults_ReturnAll(t *testing.T, r0 pkg.Int, r1 error) {
+func (_recv *M0) _OneParamTwoResults_ReturnT(t testing.TB, r0 pkg.Int, r1 error) {
+	_recv._OneParamTwoResults_DoT(t, func(pkg.String) (pkg.Int, error) { return r0, r1 })
+}
+
+func (M0) _OneParamTwoResults_ReturnAll(t testing.TB, r0 pkg.Int, r1 error) {
+	t.Helper()
 	new(M0)._OneParamTwoResults_DoAll(t, func(pkg.String) (pkg.Int, error) { return r0, r1 })
 }
 
 func (_recv *M0) _OneParamTwoResults_Calls() []_M0_OneParamTwoResults_Call {
-	if _recv == nil {
-		panic("M0.OneParamTwoResults: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.OneParamTwoResultsCalls
+	return mock.Calls(_M0Instance(_recv, "M0.OneParamTwoResults"), (*_M0Data)._OneParamTwoResults)
 }
 
 func (M0) _OneParamTwoResults_AllCalls() []_M0_OneParamTwoResults_Call {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.OneParamTwoResultsCalls
+	return mock.Calls(_M0.Global(), (*_M0Data)._OneParamTwoResults)
+}
+
+func (M0) _OneParamTwoResults_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M0.OneParamTwoResults")
+	mock.Bubble(t, _M0.Global(), func(_dat *_M0Data) { _dat.OneParamTwoResults.Calls.Clear() }, &_M0Policy.OneParamTwoResults)
+}
+
+func (M0) _OneParamTwoResults_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M0.OneParamTwoResults")
+	_M0Policy.OneParamTwoResults.Record(t, limit)
 }
 
-func (M0) _OneParamTwoResults_BubbleCalls(t *testing.T) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	_dat.OneParamTwoResultsCalls = []_M0_OneParamTwoResults_Call{}
-	t.Cleanup(func() {
-		defer _dat.mutex.Unlock()
-		_dat.mutex.Lock()
-		_dat.OneParamTwoResultsCalls = []_M0_OneParamTwoResults_Call{}
-	})
+func (_dat *_M0Data) _OneResult() *mock.Method[func() error, _M0_OneResult_Call] {
+	return &_dat.OneResult
 }
 
 func (_recv *M0) OneResult() error {
 	if _recv == nil {
 		panic("M0.OneResult: nil pointer receiver")
 	}
-	_dat := _M0PtrData(_recv)
-	_dat.mutex.Lock()
-	_dat.OneResultCalls = append(_dat.OneResultCalls, _M0_OneResult_Call{})
-	_all := _M0PtrData(nil)
-	_all.mutex.Lock()
-	_all.OneResultCalls = append(_all.OneResultCalls, _M0_OneResult_Call{})
-	var _fn func() error
-	if len(_dat.OneResultMocks) > 0 {
-		_fn = _dat.OneResultMocks[0]
-		if len(_dat.OneResultMocks) > 1 {
-			_dat.OneResultMocks = _dat.OneResultMocks[1:]
-		}
-	} else if len(_all.OneResultMocks) > 0 {
-		_fn = _all.OneResultMocks[0]
-		if len(_all.OneResultMocks) > 1 {
-			_all.OneResultMocks = _all.OneResultMocks[1:]
-		}
-	} else {
+	if _M0.Idle(&_M0Policy.OneResult) {
+		return _recv.T0.OneResult()
+	}
+	_fn, _ok := mock.Next(_M0Entry(_recv), _M0.Global(), (*_M0Data)._OneResult, &_M0Policy.OneResult, _M0_OneResult_Call{})
+	if !_ok {
 		_fn = _recv.T0.OneResult
 	}
-	_dat.mutex.Unlock()
-	_all.mutex.Unlock()
 	return _fn()
 }
 
 func (_recv *M0) _OneResult_Do(fn func() error) {
-	if _recv == nil {
-		panic("M0.OneResult: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.OneResultMocks = []func() error{}
-	} else if len(_dat.OneResultMocks) < 2 {
-		_dat.OneResultMocks = []func() error{fn, fn}
-	} else {
-		_dat.OneResultMocks = _dat.OneResultMocks[:len(_dat.OneResultMocks)-1]
-		_dat.OneResultMocks = append(_dat.OneResultMocks, fn)
-		_dat.OneResultMocks = append(_dat.OneResultMocks, fn)
-	}
-}
-
-func (M0) _OneResult_DoAll(t *testing.T, fn func() error) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.OneResultMocks = []func() error{}
-	} else if len(_dat.OneResultMocks) < 2 {
-		_dat.OneResultMocks = []func() error{fn, fn}
-	} else {
-		_dat.OneResultMocks = _dat.OneResultMocks[:len(_dat.OneResultMocks)-1]
-		_dat.OneResultMocks = append(_dat.OneResultMocks, fn)
-		_dat.OneResultMocks = append(_dat.OneResultMocks, fn)
-	}
-	_dat.once.Do(func() {
-		t.Cleanup(func() {
-			defer _dat.mutex.Unlock()
-			_dat.mutex.Lock()
-			_dat.OneResultMocks = []func() error{}
-			_dat.once = sync.Once{}
-		})
-	})
+	mock.Do(_M0Instance(_recv, "M0.OneResult"), (*_M0Data)._OneResult, fn)
+}
+
+func (_recv *M0) _OneResult_DoT(t testing.TB, fn func() error) {
+	mock.DoT(t, _M0Instance(_recv, "M0.OneResult"), (*_M0Data)._OneResult, fn)
+}
+
+func (M0) _OneResult_DoAll(t testing.TB, fn func() error) {
+	t.Helper()
+	mock.Serial(t, "M0.OneResult")
+	mock.DoAll(t, _M0.Global(), (*_M0Data)._OneResult, fn)
 }
 
 func (_recv *M0) _OneResult_Stub() {
 	_recv._OneResult_Do(func() (r0 error) { return })
 }
 
-func (M0) _OneResult_StubAll(t *testing.T) {
+func (_recv *M0) _OneResult_StubT(t testing.TB) {
+	_recv._OneResult_DoT(t, func() (r0 error) { return })
+}
+
+func (M0) _OneResult_StubAll(t testing.TB) {
+	t.Helper()
 	new(M0)._OneResult_DoAll(t, func() (r0 error) { return })
 }
 
@@ -1853,114 +1651,77 @@ func (_recv *M0) _OneResult_Return(r0 error) {
 	_recv._OneResult_Do(func() error { return r0 })
 }
 
-func (M0) _OneResult_ReturnAll(t *testing.T, r0 error) {
+func (_recv *M0) _OneResult_ReturnT(t testing.TB, r0 error) {
+	_recv._OneResult_DoT(t, func() error { return r0 })
+}
+
+func (M0) _OneResult_ReturnAll(t testing.TB, r0 error) {
+	t.Helper()
 	new(M0)._OneResult_DoAll(t, func() error { return r0 })
 }
 
 func (_recv *M0) _OneResult_Calls() []_M0_OneResult_Call {
-	if _recv == nil {
-		panic("M0.OneResult: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.OneResultCalls
+	return mock.Calls(_M0Instance(_recv, "M0.OneResult"), (*_M0Data)._OneResult)
 }
 
 func (M0) _OneResult_AllCalls() []_M0_OneResult_Call {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.OneResultCalls
+	return mock.Calls(_M0.Global(), (*_M0Data)._OneResult)
+}
+
+func (M0) _OneResult_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M0.OneResult")
+	mock.Bubble(t, _M0.Global(), func(_dat *_M0Data) { _dat.OneResult.Calls.Clear() }, &_M0Policy.OneResult)
 }
 
-func (M0) _OneResult_BubbleCalls(t *testing.T) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	_dat.OneResultCalls = []_M0_OneResult_Call{}
-	t.Cleanup(func() {
-		defer _dat.mutex.Unlock()
-		_dat.mutex.Lock()
-		_dat.OneResultCalls = []_M0_OneResult_Call{}
-	})
+func (M0) _OneResult_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M0.OneResult")
+	_M0Policy.OneResult.Record(t, limit)
+}
+
+func (_dat *_M0Data) _Read() *mock.Method[func([]byte) (int, error), _M0_Read_Call] {
+	return &_dat.Read
 }
 
 func (_recv *M0) Read(p []byte) (int, error) {
 	if _recv == nil {
 		panic("M0.Read: nil pointer receiver")
 	}
-	_dat := _M0PtrData(_recv)
-	_dat.mutex.Lock()
-	_dat.ReadCalls = append(_dat.ReadCalls, _M0_Read_Call{p})
-	_all := _M0PtrData(nil)
-	_all.mutex.Lock()
-	_all.ReadCalls = append(_all.ReadCalls, _M0_Read_Call{p})
-	var _fn func([]byte) (int, error)
-	if len(_dat.ReadMocks) > 0 {
-		_fn = _dat.ReadMocks[0]
-		if len(_dat.ReadMocks) > 1 {
-			_dat.ReadMocks = _dat.ReadMocks[1:]
-		}
-	} else if len(_all.ReadMocks) > 0 {
-		_fn = _all.ReadMocks[0]
-		if len(_all.ReadMocks) > 1 {
-			_all.ReadMocks = _all.ReadMocks[1:]
-		}
-	} else {
+	if _M0.Idle(&_M0Policy.Read) {
+		return _recv.T0.Read(p)
+	}
+	_fn, _ok := mock.Next(_M0Entry(_recv), _M0.Global(), (*_M0Data)._Read, &_M0Policy.Read, _M0_Read_Call{p})
+	if !_ok {
 		_fn = _recv.T0.Read
 	}
-	_dat.mutex.Unlock()
-	_all.mutex.Unlock()
 	return _fn(p)
 }
 
 func (_recv *M0) _Read_Do(fn func([]byte) (int, error)) {
-	if _recv == nil {
-		panic("M0.Read: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.ReadMocks = []func([]byte) (int, error){}
-	} else if len(_dat.ReadMocks) < 2 {
-		_dat.ReadMocks = []func([]byte) (int, error){fn, fn}
-	} else {
-		_dat.ReadMocks = _dat.ReadMocks[:len(_dat.ReadMocks)-1]
-		_dat.ReadMocks = append(_dat.ReadMocks, fn)
-		_dat.ReadMocks = append(_dat.ReadMocks, fn)
-	}
-}
-
-func (M0) _Read_DoAll(t *testing.T, fn func([]byte) (int, error)) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.ReadMocks = []func([]byte) (int, error){}
-	} else if len(_dat.ReadMocks) < 2 {
-		_dat.ReadMocks = []func([]byte) (int, error){fn, fn}
-	} else {
-		_dat.ReadMocks = _dat.ReadMocks[:len(_dat.ReadMocks)-1]
-		_dat.ReadMocks = append(_dat.ReadMocks, fn)
-		_dat.ReadMocks = append(_dat.ReadMocks, fn)
-	}
-	_dat.once.Do(func() {
-		t.Cleanup(func() {
-			defer _dat.mutex.Unlock()
-			_dat.mutex.Lock()
-			_dat.ReadMocks = []func([]byte) (int, error){}
-			_dat.once = sync.Once{}
-		})
-	})
+	mock.Do(_M0Instance(_recv, "M0.Read"), (*_M0Data)._Read, fn)
+}
+
+func (_recv *M0) _Read_DoT(t testing.TB, fn func([]byte) (int, error)) {
+	mock.DoT(t, _M0Instance(_recv, "M0.Read"), (*_M0Data)._Read, fn)
+}
+
+func (M0) _Read_DoAll(t testing.TB, fn func([]byte) (int, error)) {
+	t.Helper()
+	mock.Serial(t, "M0.Read")
+	mock.DoAll(t, _M0.Global(), (*_M0Data)._Read, fn)
 }
 
 func (_recv *M0) _Read_Stub() {
 	_recv._Read_Do(func([]byte) (n int, err error) { return })
 }
 
-func (M0) _Read_StubAll(t *testing.T) {
+func (_recv *M0) _Read_StubT(t testing.TB) {
+	_recv._Read_DoT(t, func([]byte) (n int, err error) { return })
+}
+
+func (M0) _Read_StubAll(t testing.TB) {
+	t.Helper()
 	new(M0)._Read_DoAll(t, func([]byte) (n int, err error) { return })
 }
 
@@ -1968,114 +1729,78 @@ func (_recv *M0) _Read_Return(n int, err error) {
 	_recv._Read_Do(func([]byte) (int, error) { return n, err })
 }
 
-func (M0) _Read_ReturnAll(t *testing.T, n int, err error) {
+func (_recv *M0) _Read_ReturnT(t testing.TB, n int, err error) {
+	_recv._Read_DoT(t, func([]byte) (int, error) { return n, err })
+}
+
+func (M0) _Read_ReturnAll(t testing.TB, n int, err error) {
+	t.Helper()
 	new(M0)._Read_DoAll(t, func([]byte) (int, error) { return n, err })
 }
 
 func (_recv *M0) _Read_Calls() []_M0_Read_Call {
-	if _recv == nil {
-		panic("M0.Read: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.ReadCalls
+	return mock.Calls(_M0Instance(_recv, "M0.Read"), (*_M0Data)._Read)
 }
 
 func (M0) _Read_AllCalls() []_M0_Read_Call {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.ReadCalls
+	return mock.Calls(_M0.Global(), (*_M0Data)._Read)
+}
+
+func (M0) _Read_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M0.Read")
+	mock.Bubble(t, _M0.Global(), func(_dat *_M0Data) { _dat.Read.Calls.Clear() }, &_M0Policy.Read)
+}
+
+func (M0) _Read_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M0.Read")
+	_M0Policy.Read.Record(t, limit)
 }
 
-func (M0) _Read_BubbleCalls(t *testing.T) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	_dat.ReadCalls = []_M0_Read_Call{}
-	t.Cleanup(func() {
-		defer _dat.mutex.Unlock()
-		_dat.mutex.Lock()
-		_dat.ReadCalls = []_M0_Read_Call{}
-	})
+func (_dat *_M0Data) _Simple() *mock.Method[func(), _M0_Simple_Call] {
+	return &_dat.Simple
 }
 
 func (_recv *M0) Simple() {
 	if _recv == nil {
 		panic("M0.Simple: nil pointer receiver")
 	}
-	_dat := _M0PtrData(_recv)
-	_dat.mutex.Lock()
-	_dat.SimpleCalls = append(_dat.SimpleCalls, _M0_Simple_Call{})
-	_all := _M0PtrData(nil)
-	_all.mutex.Lock()
-	_all.SimpleCalls = append(_all.SimpleCalls, _M0_Simple_Call{})
-	var _fn func()
-	if len(_dat.SimpleMocks) > 0 {
-		_fn = _dat.SimpleMocks[0]
-		if len(_dat.SimpleMocks) > 1 {
-			_dat.SimpleMocks = _dat.SimpleMocks[1:]
-		}
-	} else if len(_all.SimpleMocks) > 0 {
-		_fn = _all.SimpleMocks[0]
-		if len(_all.SimpleMocks) > 1 {
-			_all.SimpleMocks = _all.SimpleMocks[1:]
-		}
-	} else {
+	if _M0.Idle(&_M0Policy.Simple) {
+		_recv.T0.Simple()
+		return
+	}
+	_fn, _ok := mock.Next(_M0Entry(_recv), _M0.Global(), (*_M0Data)._Simple, &_M0Policy.Simple, _M0_Simple_Call{})
+	if !_ok {
 		_fn = _recv.T0.Simple
 	}
-	_dat.mutex.Unlock()
-	_all.mutex.Unlock()
 	_fn()
 }
 
 func (_recv *M0) _Simple_Do(fn func()) {
-	if _recv == nil {
-		panic("M0.Simple: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.SimpleMocks = []func(){}
-	} else if len(_dat.SimpleMocks) < 2 {
-		_dat.SimpleMocks = []func(){fn, fn}
-	} else {
-		_dat.SimpleMocks = _dat.SimpleMocks[:len(_dat.SimpleMocks)-1]
-		_dat.SimpleMocks = append(_dat.SimpleMocks, fn)
-		_dat.SimpleMocks = append(_dat.SimpleMocks, fn)
-	}
-}
-
-func (M0) _Simple_DoAll(t *testing.T, fn func()) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.SimpleMocks = []func(){}
-	} else if len(_dat.SimpleMocks) < 2 {
-		_dat.SimpleMocks = []func(){fn, fn}
-	} else {
-		_dat.SimpleMocks = _dat.SimpleMocks[:len(_dat.SimpleMocks)-1]
-		_dat.SimpleMocks = append(_dat.SimpleMocks, fn)
-		_dat.SimpleMocks = append(_dat.SimpleMocks, fn)
-	}
-	_dat.once.Do(func() {
-		t.Cleanup(func() {
-			defer _dat.mutex.Unlock()
-			_dat.mutex.Lock()
-			_dat.SimpleMocks = []func(){}
-			_dat.once = sync.Once{}
-		})
-	})
+	mock.Do(_M0Instance(_recv, "M0.Simple"), (*_M0Data)._Simple, fn)
+}
+
+func (_recv *M0) _Simple_DoT(t testing.TB, fn func()) {
+	mock.DoT(t, _M0Instance(_recv, "M0.Simple"), (*_M0Data)._Simple, fn)
+}
+
+func (M0) _Simple_DoAll(t testing.TB, fn func()) {
+	t.Helper()
+	mock.Serial(t, "M0.Simple")
+	mock.DoAll(t, _M0.Global(), (*_M0Data)._Simple, fn)
 }
 
 func (_recv *M0) _Simple_Stub() {
 	_recv._Simple_Do(func() { return })
 }
 
-func (M0) _Simple_StubAll(t *testing.T) {
+func (_recv *M0) _Simple_StubT(t testing.TB) {
+	_recv._Simple_DoT(t, func() { return })
+}
+
+func (M0) _Simple_StubAll(t testing.TB) {
+	t.Helper()
 	new(M0)._Simple_DoAll(t, func() { return })
 }
 
@@ -2083,114 +1808,77 @@ func (_recv *M0) _Simple_Return() {
 	_recv._Simple_Do(func() { return })
 }
 
-func (M0) _Simple_ReturnAll(t *testing.T) {
+func (_recv *M0) _Simple_ReturnT(t testing.TB) {
+	_recv._Simple_DoT(t, func() { return })
+}
+
+func (M0) _Simple_ReturnAll(t testing.TB) {
+	t.Helper()
 	new(M0)._Simple_DoAll(t, func() { return })
 }
 
 func (_recv *M0) _Simple_Calls() []_M0_Simple_Call {
-	if _recv == nil {
-		panic("M0.Simple: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.SimpleCalls
+	return mock.Calls(_M0Instance(_recv, "M0.Simple"), (*_M0Data)._Simple)
 }
 
 func (M0) _Simple_AllCalls() []_M0_Simple_Call {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.SimpleCalls
+	return mock.Calls(_M0.Global(), (*_M0Data)._Simple)
+}
+
+func (M0) _Simple_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M0.Simple")
+	mock.Bubble(t, _M0.Global(), func(_dat *_M0Data) { _dat.Simple.Calls.Clear() }, &_M0Policy.Simple)
+}
+
+func (M0) _Simple_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M0.Simple")
+	_M0Policy.Simple.Record(t, limit)
 }
 
-func (M0) _Simple_BubbleCalls(t *testing.T) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	_dat.SimpleCalls = []_M0_Simple_Call{}
-	t.Cleanup(func() {
-		defer _dat.mutex.Unlock()
-		_dat.mutex.Lock()
-		_dat.SimpleCalls = []_M0_Simple_Call{}
-	})
+func (_dat *_M0Data) _TwoNamedResults() *mock.Method[func() (pkg.Int, error), _M0_TwoNamedResults_Call] {
+	return &_dat.TwoNamedResults
 }
 
 func (_recv *M0) TwoNamedResults() (pkg.Int, error) {
 	if _recv == nil {
 		panic("M0.TwoNamedResults: nil pointer receiver")
 	}
-	_dat := _M0PtrData(_recv)
-	_dat.mutex.Lock()
-	_dat.TwoNamedResultsCalls = append(_dat.TwoNamedResultsCalls, _M0_TwoNamedResults_Call{})
-	_all := _M0PtrData(nil)
-	_all.mutex.Lock()
-	_all.TwoNamedResultsCalls = append(_all.TwoNamedResultsCalls, _M0_TwoNamedResults_Call{})
-	var _fn func() (pkg.Int, error)
-	if len(_dat.TwoNamedResultsMocks) > 0 {
-		_fn = _dat.TwoNamedResultsMocks[0]
-		if len(_dat.TwoNamedResultsMocks) > 1 {
-			_dat.TwoNamedResultsMocks = _dat.TwoNamedResultsMocks[1:]
-		}
-	} else if len(_all.TwoNamedResultsMocks) > 0 {
-		_fn = _all.TwoNamedResultsMocks[0]
-		if len(_all.TwoNamedResultsMocks) > 1 {
-			_all.TwoNamedResultsMocks = _all.TwoNamedResultsMocks[1:]
-		}
-	} else {
+	if _M0.Idle(&_M0Policy.TwoNamedResults) {
+		return _recv.T0.TwoNamedResults()
+	}
+	_fn, _ok := mock.Next(_M0Entry(_recv), _M0.Global(), (*_M0Data)._TwoNamedResults, &_M0Policy.TwoNamedResults, _M0_TwoNamedResults_Call{})
+	if !_ok {
 		_fn = _recv.T0.TwoNamedResults
 	}
-	_dat.mutex.Unlock()
-	_all.mutex.Unlock()
 	return _fn()
 }
 
 func (_recv *M0) _TwoNamedResults_Do(fn func() (pkg.Int, error)) {
-	if _recv == nil {
-		panic("M0.TwoNamedResults: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.TwoNamedResultsMocks = []func() (pkg.Int, error){}
-	} else if len(_dat.TwoNamedResultsMocks) < 2 {
-		_dat.TwoNamedResultsMocks = []func() (pkg.Int, error){fn, fn}
-	} else {
-		_dat.TwoNamedResultsMocks = _dat.TwoNamedResultsMocks[:len(_dat.TwoNamedResultsMocks)-1]
-		_dat.TwoNamedResultsMocks = append(_dat.TwoNamedResultsMocks, fn)
-		_dat.TwoNamedResultsMocks = append(_dat.TwoNamedResultsMocks, fn)
-	}
-}
-
-func (M0) _TwoNamedResults_DoAll(t *testing.T, fn func() (pkg.Int, error)) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.TwoNamedResultsMocks = []func() (pkg.Int, error){}
-	} else if len(_dat.TwoNamedResultsMocks) < 2 {
-		_dat.TwoNamedResultsMocks = []func() (pkg.Int, error){fn, fn}
-	} else {
-		_dat.TwoNamedResultsMocks = _dat.TwoNamedResultsMocks[:len(_dat.TwoNamedResultsMocks)-1]
-		_dat.TwoNamedResultsMocks = append(_dat.TwoNamedResultsMocks, fn)
-		_dat.TwoNamedResultsMocks = append(_dat.TwoNamedResultsMocks, fn)
-	}
-	_dat.once.Do(func() {
-		t.Cleanup(func() {
-			defer _dat.mutex.Unlock()
-			_dat.mutex.Lock()
-			_dat.TwoNamedResultsMocks = []func() (pkg.Int, error){}
-			_dat.once = sync.Once{}
-		})
-	})
+	mock.Do(_M0Instance(_recv, "M0.TwoNamedResults"), (*_M0Data)._TwoNamedResults, fn)
+}
+
+func (_recv *M0) _TwoNamedResults_DoT(t testing.TB, fn func() (pkg.Int, error)) {
+	mock.DoT(t, _M0Instance(_recv, "M0.TwoNamedResults"), (*_M0Data)._TwoNamedResults, fn)
+}
+
+func (M0) _TwoNamedResults_DoAll(t testing.TB, fn func() (pkg.Int, error)) {
+	t.Helper()
+	mock.Serial(t, "M0.TwoNamedResults")
+	mock.DoAll(t, _M0.Global(), (*_M0Data)._TwoNamedResults, fn)
 }
 
 func (_recv *M0) _TwoNamedResults_Stub() {
 	_recv._TwoNamedResults_Do(func() (n pkg.Int, err error) { return })
 }
 
-func (M0) _TwoNamedResults_StubAll(t *testing.T) {
+func (_recv *M0) _TwoNamedResults_StubT(t testing.TB) {
+	_recv._TwoNamedResults_DoT(t, func() (n pkg.Int, err error) { return })
+}
+
+func (M0) _TwoNamedResults_StubAll(t testing.TB) {
+	t.Helper()
 	new(M0)._TwoNamedResults_DoAll(t, func() (n pkg.Int, err error) { return })
 }
 
@@ -2198,114 +1886,78 @@ func (_recv *M0) _TwoNamedResults_Return(n pkg.Int, err error) {
 	_recv._TwoNamedResults_Do(func() (pkg.Int, error) { return n, err })
 }
 
-func (M0) _TwoNamedResults_ReturnAll(t *testing.T, n pkg.Int, err error) {
+func (_recv *M0) _TwoNamedResults_ReturnT(t testing.TB, n pkg.Int, err error) {
+	_recv._TwoNamedResults_DoT(t, func() (pkg.Int, error) { return n, err })
+}
+
+func (M0) _TwoNamedResults_ReturnAll(t testing.TB, n pkg.Int, err error) {
+	t.Helper()
 	new(M0)._TwoNamedResults_DoAll(t, func() (pkg.Int, error) { return n, err })
 }
 
 func (_recv *M0) _TwoNamedResults_Calls() []_M0_TwoNamedResults_Call {
-	if _recv == nil {
-		panic("M0.TwoNamedResults: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.TwoNamedResultsCalls
+	return mock.Calls(_M0Instance(_recv, "M0.TwoNamedResults"), (*_M0Data)._TwoNamedResults)
 }
 
 func (M0) _TwoNamedResults_AllCalls() []_M0_TwoNamedResults_Call {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.TwoNamedResultsCalls
+	return mock.Calls(_M0.Global(), (*_M0Data)._TwoNamedResults)
+}
+
+func (M0) _TwoNamedResults_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M0.TwoNamedResults")
+	mock.Bubble(t, _M0.Global(), func(_dat *_M0Data) { _dat.TwoNamedResults.Calls.Clear() }, &_M0Policy.TwoNamedResults)
+}
+
+func (M0) _TwoNamedResults_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M0.TwoNamedResults")
+	_M0Policy.TwoNamedResults.Record(t, limit)
 }
 
-func (M0) _TwoNamedResults_BubbleCalls(t *testing.T) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	_dat.TwoNamedResultsCalls = []_M0_TwoNamedResults_Call{}
-	t.Cleanup(func() {
-		defer _dat.mutex.Unlock()
-		_dat.mutex.Lock()
-		_dat.TwoNamedResultsCalls = []_M0_TwoNamedResults_Call{}
-	})
+func (_dat *_M0Data) _TwoParamsNoResult() *mock.Method[func(pkg.String, pkg.String), _M0_TwoParamsNoResult_Call] {
+	return &_dat.TwoParamsNoResult
 }
 
 func (_recv *M0) TwoParamsNoResult(P0 pkg.String, P1 pkg.String) {
 	if _recv == nil {
 		panic("M0.TwoParamsNoResult: nil pointer receiver")
 	}
-	_dat := _M0PtrData(_recv)
-	_dat.mutex.Lock()
-	_dat.TwoParamsNoResultCalls = append(_dat.TwoParamsNoResultCalls, _M0_TwoParamsNoResult_Call{P0, P1})
-	_all := _M0PtrData(nil)
-	_all.mutex.Lock()
-	_all.TwoParamsNoResultCalls = append(_all.TwoParamsNoResultCalls, _M0_TwoParamsNoResult_Call{P0, P1})
-	var _fn func(pkg.String, pkg.String)
-	if len(_dat.TwoParamsNoResultMocks) > 0 {
-		_fn = _dat.TwoParamsNoResultMocks[0]
-		if len(_dat.TwoParamsNoResultMocks) > 1 {
-			_dat.TwoParamsNoResultMocks = _dat.TwoParamsNoResultMocks[1:]
-		}
-	} else if len(_all.TwoParamsNoResultMocks) > 0 {
-		_fn = _all.TwoParamsNoResultMocks[0]
-		if len(_all.TwoParamsNoResultMocks) > 1 {
-			_all.TwoParamsNoResultMocks = _all.TwoParamsNoResultMocks[1:]
-		}
-	} else {
+	if _M0.Idle(&_M0Policy.TwoParamsNoResult) {
+		_recv.T0.TwoParamsNoResult(P0, P1)
+		return
+	}
+	_fn, _ok := mock.Next(_M0Entry(_recv), _M0.Global(), (*_M0Data)._TwoParamsNoResult, &_M0Policy.TwoParamsNoResult, _M0_TwoParamsNoResult_Call{P0, P1})
+	if !_ok {
 		_fn = _recv.T0.TwoParamsNoResult
 	}
-	_dat.mutex.Unlock()
-	_all.mutex.Unlock()
 	_fn(P0, P1)
 }
 
 func (_recv *M0) _TwoParamsNoResult_Do(fn func(pkg.String, pkg.String)) {
-	if _recv == nil {
-		panic("M0.TwoParamsNoResult: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.TwoParamsNoResultMocks = []func(pkg.String, pkg.String){}
-	} else if len(_dat.TwoParamsNoResultMocks) < 2 {
-		_dat.TwoParamsNoResultMocks = []func(pkg.String, pkg.String){fn, fn}
-	} else {
-		_dat.TwoParamsNoResultMocks = _dat.TwoParamsNoResultMocks[:len(_dat.TwoParamsNoResultMocks)-1]
-		_dat.TwoParamsNoResultMocks = append(_dat.TwoParamsNoResultMocks, fn)
-		_dat.TwoParamsNoResultMocks = append(_dat.TwoParamsNoResultMocks, fn)
-	}
-}
-
-func (M0) _TwoParamsNoResult_DoAll(t *testing.T, fn func(pkg.String, pkg.String)) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.TwoParamsNoResultMocks = []func(pkg.String, pkg.String){}
-	} else if len(_dat.TwoParamsNoResultMocks) < 2 {
-		_dat.TwoParamsNoResultMocks = []func(pkg.String, pkg.String){fn, fn}
-	} else {
-		_dat.TwoParamsNoResultMocks = _dat.TwoParamsNoResultMocks[:len(_dat.TwoParamsNoResultMocks)-1]
-		_dat.TwoParamsNoResultMocks = append(_dat.TwoParamsNoResultMocks, fn)
-		_dat.TwoParamsNoResultMocks = append(_dat.TwoParamsNoResultMocks, fn)
-	}
-	_dat.once.Do(func() {
-		t.Cleanup(func() {
-			defer _dat.mutex.Unlock()
-			_dat.mutex.Lock()
-			_dat.TwoParamsNoResultMocks = []func(pkg.String, pkg.String){}
-			_dat.once = sync.Once{}
-		})
-	})
+	mock.Do(_M0Instance(_recv, "M0.TwoParamsNoResult"), (*_M0Data)._TwoParamsNoResult, fn)
+}
+
+func (_recv *M0) _TwoParamsNoResult_DoT(t testing.TB, fn func(pkg.String, pkg.String)) {
+	mock.DoT(t, _M0Instance(_recv, "M0.TwoParamsNoResult"), (*_M0Data)._TwoParamsNoResult, fn)
+}
+
+func (M0) _TwoParamsNoResult_DoAll(t testing.TB, fn func(pkg.String, pkg.String)) {
+	t.Helper()
+	mock.Serial(t, "M0.TwoParamsNoResult")
+	mock.DoAll(t, _M0.Global(), (*_M0Data)._TwoParamsNoResult, fn)
 }
 
 func (_recv *M0) _TwoParamsNoResult_Stub() {
 	_recv._TwoParamsNoResult_Do(func(pkg.String, pkg.String) { return })
 }
 
-func (M0) _TwoParamsNoResult_StubAll(t *testing.T) {
+func (_recv *M0) _TwoParamsNoResult_StubT(t testing.TB) {
+	_recv._TwoParamsNoResult_DoT(t, func(pkg.String, pkg.String) { return })
+}
+
+func (M0) _TwoParamsNoResult_StubAll(t testing.TB) {
+	t.Helper()
 	new(M0)._TwoParamsNoResult_DoAll(t, func(pkg.String, pkg.String) { return })
 }
 
@@ -2313,114 +1965,77 @@ func (_recv *M0) _TwoParamsNoResult_Return() {
 	_recv._TwoParamsNoResult_Do(func(pkg.String, pkg.String) { return })
 }
 
-func (M0) _TwoParamsNoResult_ReturnAll(t *testing.T) {
+func (_recv *M0) _TwoParamsNoResult_ReturnT(t testing.TB) {
+	_recv._TwoParamsNoResult_DoT(t, func(pkg.String, pkg.String) { return })
+}
+
+func (M0) _TwoParamsNoResult_ReturnAll(t testing.TB) {
+	t.Helper()
 	new(M0)._TwoParamsNoResult_DoAll(t, func(pkg.String, pkg.String) { return })
 }
 
 func (_recv *M0) _TwoParamsNoResult_Calls() []_M0_TwoParamsNoResult_Call {
-	if _recv == nil {
-		panic("M0.TwoParamsNoResult: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.TwoParamsNoResultCalls
+	return mock.Calls(_M0Instance(_recv, "M0.TwoParamsNoResult"), (*_M0Data)._TwoParamsNoResult)
 }
 
 func (M0) _TwoParamsNoResult_AllCalls() []_M0_TwoParamsNoResult_Call {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.TwoParamsNoResultCalls
+	return mock.Calls(_M0.Global(), (*_M0Data)._TwoParamsNoResult)
+}
+
+func (M0) _TwoParamsNoResult_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M0.TwoParamsNoResult")
+	mock.Bubble(t, _M0.Global(), func(_dat *_M0Data) { _dat.TwoParamsNoResult.Calls.Clear() }, &_M0Policy.TwoParamsNoResult)
 }
 
-func (M0) _TwoParamsNoResult_BubbleCalls(t *testing.T) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	_dat.TwoParamsNoResultCalls = []_M0_TwoParamsNoResult_Call{}
-	t.Cleanup(func() {
-		defer _dat.mutex.Unlock()
-		_dat.mutex.Lock()
-		_dat.TwoParamsNoResultCalls = []_M0_TwoParamsNoResult_Call{}
-	})
+func (M0) _TwoParamsNoResult_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M0.TwoParamsNoResult")
+	_M0Policy.TwoParamsNoResult.Record(t, limit)
+}
+
+func (_dat *_M0Data) _TwoParamsOneResult() *mock.Method[func(pkg.String, pkg.String) error, _M0_TwoParamsOneResult_Call] {
+	return &_dat.TwoParamsOneResult
 }
 
 func (_recv *M0) TwoParamsOneResult(P0 pkg.String, P1 pkg.String) error {
 	if _recv == nil {
 		panic("M0.TwoParamsOneResult: nil pointer receiver")
 	}
-	_dat := _M0PtrData(_recv)
-	_dat.mutex.Lock()
-	_dat.TwoParamsOneResultCalls = append(_dat.TwoParamsOneResultCalls, _M0_TwoParamsOneResult_Call{P0, P1})
-	_all := _M0PtrData(nil)
-	_all.mutex.Lock()
-	_all.TwoParamsOneResultCalls = append(_all.TwoParamsOneResultCalls, _M0_TwoParamsOneResult_Call{P0, P1})
-	var _fn func(pkg.String, pkg.String) error
-	if len(_dat.TwoParamsOneResultMocks) > 0 {
-		_fn = _dat.TwoParamsOneResultMocks[0]
-		if len(_dat.TwoParamsOneResultMocks) > 1 {
-			_dat.TwoParamsOneResultMocks = _dat.TwoParamsOneResultMocks[1:]
-		}
-	} else if len(_all.TwoParamsOneResultMocks) > 0 {
-		_fn = _all.TwoParamsOneResultMocks[0]
-		if len(_all.TwoParamsOneResultMocks) > 1 {
-			_all.TwoParamsOneResultMocks = _all.TwoParamsOneResultMocks[1:]
-		}
-	} else {
+	if _M0.Idle(&_M0Policy.TwoParamsOneResult) {
+		return _recv.T0.TwoParamsOneResult(P0, P1)
+	}
+	_fn, _ok := mock.Next(_M0Entry(_recv), _M0.Global(), (*_M0Data)._TwoParamsOneResult, &_M0Policy.TwoParamsOneResult, _M0_TwoParamsOneResult_Call{P0, P1})
+	if !_ok {
 		_fn = _recv.T0.TwoParamsOneResult
 	}
-	_dat.mutex.Unlock()
-	_all.mutex.Unlock()
 	return _fn(P0, P1)
 }
 
 func (_recv *M0) _TwoParamsOneResult_Do(fn func(pkg.String, pkg.String) error) {
-	if _recv == nil {
-		panic("M0.TwoParamsOneResult: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.TwoParamsOneResultMocks = []func(pkg.String, pkg.String) error{}
-	} else if len(_dat.TwoParamsOneResultMocks) < 2 {
-		_dat.TwoParamsOneResultMocks = []func(pkg.String, pkg.String) error{fn, fn}
-	} else {
-		_dat.TwoParamsOneResultMocks = _dat.TwoParamsOneResultMocks[:len(_dat.TwoParamsOneResultMocks)-1]
-		_dat.TwoParamsOneResultMocks = append(_dat.TwoParamsOneResultMocks, fn)
-		_dat.TwoParamsOneResultMocks = append(_dat.TwoParamsOneResultMocks, fn)
-	}
-}
-
-func (M0) _TwoParamsOneResult_DoAll(t *testing.T, fn func(pkg.String, pkg.String) error) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.TwoParamsOneResultMocks = []func(pkg.String, pkg.String) error{}
-	} else if len(_dat.TwoParamsOneResultMocks) < 2 {
-		_dat.TwoParamsOneResultMocks = []func(pkg.String, pkg.String) error{fn, fn}
-	} else {
-		_dat.TwoParamsOneResultMocks = _dat.TwoParamsOneResultMocks[:len(_dat.TwoParamsOneResultMocks)-1]
-		_dat.TwoParamsOneResultMocks = append(_dat.TwoParamsOneResultMocks, fn)
-		_dat.TwoParamsOneResultMocks = append(_dat.TwoParamsOneResultMocks, fn)
-	}
-	_dat.once.Do(func() {
-		t.Cleanup(func() {
-			defer _dat.mutex.Unlock()
-			_dat.mutex.Lock()
-			_dat.TwoParamsOneResultMocks = []func(pkg.String, pkg.String) error{}
-			_dat.once = sync.Once{}
-		})
-	})
+	mock.Do(_M0Instance(_recv, "M0.TwoParamsOneResult"), (*_M0Data)._TwoParamsOneResult, fn)
+}
+
+func (_recv *M0) _TwoParamsOneResult_DoT(t testing.TB, fn func(pkg.String, pkg.String) error) {
+	mock.DoT(t, _M0Instance(_recv, "M0.TwoParamsOneResult"), (*_M0Data)._TwoParamsOneResult, fn)
+}
+
+func (M0) _TwoParamsOneResult_DoAll(t testing.TB, fn func(pkg.String, pkg.String) error) {
+	t.Helper()
+	mock.Serial(t, "M0.TwoParamsOneResult")
+	mock.DoAll(t, _M0.Global(), (*_M0Data)._TwoParamsOneResult, fn)
 }
 
 func (_recv *M0) _TwoParamsOneResult_Stub() {
 	_recv._TwoParamsOneResult_Do(func(pkg.String, pkg.String) (r0 error) { return })
 }
 
-func (M0) _TwoParamsOneResult_StubAll(t *testing.T) {
+func (_recv *M0) _TwoParamsOneResult_StubT(t testing.TB) {
+	_recv._TwoParamsOneResult_DoT(t, func(pkg.String, pkg.String) (r0 error) { return })
+}
+
+func (M0) _TwoParamsOneResult_StubAll(t testing.TB) {
+	t.Helper()
 	new(M0)._TwoParamsOneResult_DoAll(t, func(pkg.String, pkg.String) (r0 error) { return })
 }
 
@@ -2428,114 +2043,77 @@ func (_recv *M0) _TwoParamsOneResult_Return(r0 error) {
 	_recv._TwoParamsOneResult_Do(func(pkg.String, pkg.String) error { return r0 })
 }
 
-func (M0) _TwoParamsOneResult_ReturnAll(t *testing.T, r0 error) {
+func (_recv *M0) _TwoParamsOneResult_ReturnT(t testing.TB, r0 error) {
+	_recv._TwoParamsOneResult_DoT(t, func(pkg.String, pkg.String) error { return r0 })
+}
+
+func (M0) _TwoParamsOneResult_ReturnAll(t testing.TB, r0 error) {
+	t.Helper()
 	new(M0)._TwoParamsOneResult_DoAll(t, func(pkg.String, pkg.String) error { return r0 })
 }
 
 func (_recv *M0) _TwoParamsOneResult_Calls() []_M0_TwoParamsOneResult_Call {
-	if _recv == nil {
-		panic("M0.TwoParamsOneResult: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.TwoParamsOneResultCalls
+	return mock.Calls(_M0Instance(_recv, "M0.TwoParamsOneResult"), (*_M0Data)._TwoParamsOneResult)
 }
 
 func (M0) _TwoParamsOneResult_AllCalls() []_M0_TwoParamsOneResult_Call {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.TwoParamsOneResultCalls
+	return mock.Calls(_M0.Global(), (*_M0Data)._TwoParamsOneResult)
+}
+
+func (M0) _TwoParamsOneResult_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M0.TwoParamsOneResult")
+	mock.Bubble(t, _M0.Global(), func(_dat *_M0Data) { _dat.TwoParamsOneResult.Calls.Clear() }, &_M0Policy.TwoParamsOneResult)
+}
+
+func (M0) _TwoParamsOneResult_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M0.TwoParamsOneResult")
+	_M0Policy.TwoParamsOneResult.Record(t, limit)
 }
 
-func (M0) _TwoParamsOneResult_BubbleCalls(t *testing.T) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	_dat.TwoParamsOneResultCalls = []_M0_TwoParamsOneResult_Call{}
-	t.Cleanup(func() {
-		defer _dat.mutex.Unlock()
-		_dat.mutex.Lock()
-		_dat.TwoParamsOneResultCalls = []_M0_TwoParamsOneResult_Call{}
-	})
+func (_dat *_M0Data) _TwoParamsTwoResults() *mock.Method[func(pkg.String, pkg.String) (pkg.Int, error), _M0_TwoParamsTwoResults_Call] {
+	return &_dat.TwoParamsTwoResults
 }
 
 func (_recv *M0) TwoParamsTwoResults(P0 pkg.String, P1 pkg.String) (pkg.Int, error) {
 	if _recv == nil {
 		panic("M0.TwoParamsTwoResults: nil pointer receiver")
 	}
-	_dat := _M0PtrData(_recv)
-	_dat.mutex.Lock()
-	_dat.TwoParamsTwoResultsCalls = append(_dat.TwoParamsTwoResultsCalls, _M0_TwoParamsTwoResults_Call{P0, P1})
-	_all := _M0PtrData(nil)
-	_all.mutex.Lock()
-	_all.TwoParamsTwoResultsCalls = append(_all.TwoParamsTwoResultsCalls, _M0_TwoParamsTwoResults_Call{P0, P1})
-	var _fn func(pkg.String, pkg.String) (pkg.Int, error)
-	if len(_dat.TwoParamsTwoResultsMocks) > 0 {
-		_fn = _dat.TwoParamsTwoResultsMocks[0]
-		if len(_dat.TwoParamsTwoResultsMocks) > 1 {
-			_dat.TwoParamsTwoResultsMocks = _dat.TwoParamsTwoResultsMocks[1:]
-		}
-	} else if len(_all.TwoParamsTwoResultsMocks) > 0 {
-		_fn = _all.TwoParamsTwoResultsMocks[0]
-		if len(_all.TwoParamsTwoResultsMocks) > 1 {
-			_all.TwoParamsTwoResultsMocks = _all.TwoParamsTwoResultsMocks[1:]
-		}
-	} else {
+	if _M0.Idle(&_M0Policy.TwoParamsTwoResults) {
+		return _recv.T0.TwoParamsTwoResults(P0, P1)
+	}
+	_fn, _ok := mock.Next(_M0Entry(_recv), _M0.Global(), (*_M0Data)._TwoParamsTwoResults, &_M0Policy.TwoParamsTwoResults, _M0_TwoParamsTwoResults_Call{P0, P1})
+	if !_ok {
 		_fn = _recv.T0.TwoParamsTwoResults
 	}
-	_dat.mutex.Unlock()
-	_all.mutex.Unlock()
 	return _fn(P0, P1)
 }
 
 func (_recv *M0) _TwoParamsTwoResults_Do(fn func(pkg.String, pkg.String) (pkg.Int, error)) {
-	if _recv == nil {
-		panic("M0.TwoParamsTwoResults: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.TwoParamsTwoResultsMocks = []func(pkg.String, pkg.String) (pkg.Int, error){}
-	} else if len(_dat.TwoParamsTwoResultsMocks) < 2 {
-		_dat.TwoParamsTwoResultsMocks = []func(pkg.String, pkg.String) (pkg.Int, error){fn, fn}
-	} else {
-		_dat.TwoParamsTwoResultsMocks = _dat.TwoParamsTwoResultsMocks[:len(_dat.TwoParamsTwoResultsMocks)-1]
-		_dat.TwoParamsTwoResultsMocks = append(_dat.TwoParamsTwoResultsMocks, fn)
-		_dat.TwoParamsTwoResultsMocks = append(_dat.TwoParamsTwoResultsMocks, fn)
-	}
-}
-
-func (M0) _TwoParamsTwoResults_DoAll(t *testing.T, fn func(pkg.String, pkg.String) (pkg.Int, error)) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.TwoParamsTwoResultsMocks = []func(pkg.String, pkg.String) (pkg.Int, error){}
-	} else if len(_dat.TwoParamsTwoResultsMocks) < 2 {
-		_dat.TwoParamsTwoResultsMocks = []func(pkg.String, pkg.String) (pkg.Int, error){fn, fn}
-	} else {
-		_dat.TwoParamsTwoResultsMocks = _dat.TwoParamsTwoResultsMocks[:len(_dat.TwoParamsTwoResultsMocks)-1]
-		_dat.TwoParamsTwoResultsMocks = append(_dat.TwoParamsTwoResultsMocks, fn)
-		_dat.TwoParamsTwoResultsMocks = append(_dat.TwoParamsTwoResultsMocks, fn)
-	}
-	_dat.once.Do(func() {
-		t.Cleanup(func() {
-			defer _dat.mutex.Unlock()
-			_dat.mutex.Lock()
-			_dat.TwoParamsTwoResultsMocks = []func(pkg.String, pkg.String) (pkg.Int, error){}
-			_dat.once = sync.Once{}
-		})
-	})
+	mock.Do(_M0Instance(_recv, "M0.TwoParamsTwoResults"), (*_M0Data)._TwoParamsTwoResults, fn)
+}
+
+func (_recv *M0) _TwoParamsTwoResults_DoT(t testing.TB, fn func(pkg.String, pkg.String) (pkg.Int, error)) {
+	mock.DoT(t, _M0Instance(_recv, "M0.TwoParamsTwoResults"), (*_M0Data)._TwoParamsTwoResults, fn)
+}
+
+func (M0) _TwoParamsTwoResults_DoAll(t testing.TB, fn func(pkg.String, pkg.String) (pkg.Int, error)) {
+	t.Helper()
+	mock.Serial(t, "M0.TwoParamsTwoResults")
+	mock.DoAll(t, _M0.Global(), (*_M0Data)._TwoParamsTwoResults, fn)
 }
 
 func (_recv *M0) _TwoParamsTwoResults_Stub() {
 	_recv._TwoParamsTwoResults_Do(func(pkg.String, pkg.String) (r0 pkg.Int, r1 error) { return })
 }
 
-func (M0) _TwoParamsTwoResults_StubAll(t *testing.T) {
+func (_recv *M0) _TwoParamsTwoResults_StubT(t testing.TB) {
+	_recv._TwoParamsTwoResults_DoT(t, func(pkg.String, pkg.String) (r0 pkg.Int, r1 error) { return })
+}
+
+func (M0) _TwoParamsTwoResults_StubAll(t testing.TB) {
+	t.Helper()
 	new(M0)._TwoParamsTwoResults_DoAll(t, func(pkg.String, pkg.String) (r0 pkg.Int, r1 error) { return })
 }
 
@@ -2543,114 +2121,77 @@ func (_recv *M0) _TwoParamsTwoResults_Return(r0 pkg.Int, r1 error) {
 	_recv._TwoParamsTwoResults_Do(func(pkg.String, pkg.String) (pkg.Int, error) { return r0, r1 })
 }
 
-func (M0) _TwoParamsTwoResults_ReturnAll(t *testing.T, r0 pkg.Int, r1 error) {
+func (_recv *M0) _TwoParamsTwoResults_ReturnT(t testing.TB, r0 pkg.Int, r1 error) {
+	_recv._TwoParamsTwoResults_DoT(t, func(pkg.String, pkg.String) (pkg.Int, error) { return r0, r1 })
+}
+
+func (M0) _TwoParamsTwoResults_ReturnAll(t testing.TB, r0 pkg.Int, r1 error) {
+	t.Helper()
 	new(M0)._TwoParamsTwoResults_DoAll(t, func(pkg.String, pkg.String) (pkg.Int, error) { return r0, r1 })
 }
 
 func (_recv *M0) _TwoParamsTwoResults_Calls() []_M0_TwoParamsTwoResults_Call {
-	if _recv == nil {
-		panic("M0.TwoParamsTwoResults: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.TwoParamsTwoResultsCalls
+	return mock.Calls(_M0Instance(_recv, "M0.TwoParamsTwoResults"), (*_M0Data)._TwoParamsTwoResults)
 }
 
 func (M0) _TwoParamsTwoResults_AllCalls() []_M0_TwoParamsTwoResults_Call {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.TwoParamsTwoResultsCalls
+	return mock.Calls(_M0.Global(), (*_M0Data)._TwoParamsTwoResults)
 }
 
-func (M0) _TwoParamsTwoResults_BubbleCalls(t *testing.T) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	_dat.TwoParamsTwoResultsCalls = []_M0_TwoParamsTwoResults_Call{}
-	t.Cleanup(func() {
-		defer _dat.mutex.Unlock()
-		_dat.mutex.Lock()
-		_dat.TwoParamsTwoResultsCalls = []_M0_TwoParamsTwoResults_Call{}
-	})
+func (M0) _TwoParamsTwoResults_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M0.TwoParamsTwoResults")
+	mock.Bubble(t, _M0.Global(), func(_dat *_M0Data) { _dat.TwoParamsTwoResults.Calls.Clear() }, &_M0Policy.TwoParamsTwoResults)
+}
+
+func (M0) _TwoParamsTwoResults_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M0.TwoParamsTwoResults")
+	_M0Policy.TwoParamsTwoResults.Record(t, limit)
+}
+
+func (_dat *_M0Data) _TwoResults() *mock.Method[func() (pkg.Int, error), _M0_TwoResults_Call] {
+	return &_dat.TwoResults
 }
 
 func (_recv *M0) TwoResults() (pkg.Int, error) {
 	if _recv == nil {
 		panic("M0.TwoResults: nil pointer receiver")
 	}
-	_dat := _M0PtrData(_recv)
-	_dat.mutex.Lock()
-	_dat.TwoResultsCalls = append(_dat.TwoResultsCalls, _M0_TwoResults_Call{})
-	_all := _M0PtrData(nil)
-	_all.mutex.Lock()
-	_all.TwoResultsCalls = append(_all.TwoResultsCalls, _M0_TwoResults_Call{})
-	var _fn func() (pkg.Int, error)
-	if len(_dat.TwoResultsMocks) > 0 {
-		_fn = _dat.TwoResultsMocks[0]
-		if len(_dat.TwoResultsMocks) > 1 {
-			_dat.TwoResultsMocks = _dat.TwoResultsMocks[1:]
-		}
-	} else if len(_all.TwoResultsMocks) > 0 {
-		_fn = _all.TwoResultsMocks[0]
-		if len(_all.TwoResultsMocks) > 1 {
-			_all.TwoResultsMocks = _all.TwoResultsMocks[1:]
-		}
-	} else {
+	if _M0.Idle(&_M0Policy.TwoResults) {
+		return _recv.T0.TwoResults()
+	}
+	_fn, _ok := mock.Next(_M0Entry(_recv), _M0.Global(), (*_M0Data)._TwoResults, &_M0Policy.TwoResults, _M0_TwoResults_Call{})
+	if !_ok {
 		_fn = _recv.T0.TwoResults
 	}
-	_dat.mutex.Unlock()
-	_all.mutex.Unlock()
 	return _fn()
 }
 
 func (_recv *M0) _TwoResults_Do(fn func() (pkg.Int, error)) {
-	if _recv == nil {
-		panic("M0.TwoResults: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.TwoResultsMocks = []func() (pkg.Int, error){}
-	} else if len(_dat.TwoResultsMocks) < 2 {
-		_dat.TwoResultsMocks = []func() (pkg.Int, error){fn, fn}
-	} else {
-		_dat.TwoResultsMocks = _dat.TwoResultsMocks[:len(_dat.TwoResultsMocks)-1]
-		_dat.TwoResultsMocks = append(_dat.TwoResultsMocks, fn)
-		_dat.TwoResultsMocks = append(_dat.TwoResultsMocks, fn)
-	}
-}
-
-func (M0) _TwoResults_DoAll(t *testing.T, fn func() (pkg.Int, error)) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.TwoResultsMocks = []func() (pkg.Int, error){}
-	} else if len(_dat.TwoResultsMocks) < 2 {
-		_dat.TwoResultsMocks = []func() (pkg.Int, error){fn, fn}
-	} else {
-		_dat.TwoResultsMocks = _dat.TwoResultsMocks[:len(_dat.TwoResultsMocks)-1]
-		_dat.TwoResultsMocks = append(_dat.TwoResultsMocks, fn)
-		_dat.TwoResultsMocks = append(_dat.TwoResultsMocks, fn)
-	}
-	_dat.once.Do(func() {
-		t.Cleanup(func() {
-			defer _dat.mutex.Unlock()
-			_dat.mutex.Lock()
-			_dat.TwoResultsMocks = []func() (pkg.Int, error){}
-			_dat.once = sync.Once{}
-		})
-	})
+	mock.Do(_M0Instance(_recv, "M0.TwoResults"), (*_M0Data)._TwoResults, fn)
+}
+
+func (_recv *M0) _TwoResults_DoT(t testing.TB, fn func() (pkg.Int, error)) {
+	mock.DoT(t, _M0Instance(_recv, "M0.TwoResults"), (*_M0Data)._TwoResults, fn)
+}
+
+func (M0) _TwoResults_DoAll(t testing.TB, fn func() (pkg.Int, error)) {
+	t.Helper()
+	mock.Serial(t, "M0.TwoResults")
+	mock.DoAll(t, _M0.Global(), (*_M0Data)._TwoResults, fn)
 }
 
 func (_recv *M0) _TwoResults_Stub() {
 	_recv._TwoResults_Do(func() (r0 pkg.Int, r1 error) { return })
 }
 
-func (M0) _TwoResults_StubAll(t *testing.T) {
+func (_recv *M0) _TwoResults_StubT(t testing.TB) {
+	_recv._TwoResults_DoT(t, func() (r0 pkg.Int, r1 error) { return })
+}
+
+func (M0) _TwoResults_StubAll(t testing.TB) {
+	t.Helper()
 	new(M0)._TwoResults_DoAll(t, func() (r0 pkg.Int, r1 error) { return })
 }
 
@@ -2658,114 +2199,78 @@ func (_recv *M0) _TwoResults_Return(r0 pkg.Int, r1 error) {
 	_recv._TwoResults_Do(func() (pkg.Int, error) { return r0, r1 })
 }
 
-func (M0) _TwoResults_ReturnAll(t *testing.T, r0 pkg.Int, r1 error) {
+func (_recv *M0) _TwoResults_ReturnT(t testing.TB, r0 pkg.Int, r1 error) {
+	_recv._TwoResults_DoT(t, func() (pkg.Int, error) { return r0, r1 })
+}
+
+func (M0) _TwoResults_ReturnAll(t testing.TB, r0 pkg.Int, r1 error) {
+	t.Helper()
 	new(M0)._TwoResults_DoAll(t, func() (pkg.Int, error) { return r0, r1 })
 }
 
 func (_recv *M0) _TwoResults_Calls() []_M0_TwoResults_Call {
-	if _recv == nil {
-		panic("M0.TwoResults: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.TwoResultsCalls
+	return mock.Calls(_M0Instance(_recv, "M0.TwoResults"), (*_M0Data)._TwoResults)
 }
 
 func (M0) _TwoResults_AllCalls() []_M0_TwoResults_Call {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.TwoResultsCalls
+	return mock.Calls(_M0.Global(), (*_M0Data)._TwoResults)
+}
+
+func (M0) _TwoResults_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M0.TwoResults")
+	mock.Bubble(t, _M0.Global(), func(_dat *_M0Data) { _dat.TwoResults.Calls.Clear() }, &_M0Policy.TwoResults)
+}
+
+func (M0) _TwoResults_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M0.TwoResults")
+	_M0Policy.TwoResults.Record(t, limit)
 }
 
-func (M0) _TwoResults_BubbleCalls(t *testing.T) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	_dat.TwoResultsCalls = []_M0_TwoResults_Call{}
-	t.Cleanup(func() {
-		defer _dat.mutex.Unlock()
-		_dat.mutex.Lock()
-		_dat.TwoResultsCalls = []_M0_TwoResults_Call{}
-	})
+func (_dat *_M0Data) _VariadicNoResult() *mock.Method[func(...pkg.String), _M0_VariadicNoResult_Call] {
+	return &_dat.VariadicNoResult
 }
 
 func (_recv *M0) VariadicNoResult(P0 ...pkg.String) {
 	if _recv == nil {
 		panic("M0.VariadicNoResult: nil pointer receiver")
 	}
-	_dat := _M0PtrData(_recv)
-	_dat.mutex.Lock()
-	_dat.VariadicNoResultCalls = append(_dat.VariadicNoResultCalls, _M0_VariadicNoResult_Call{P0})
-	_all := _M0PtrData(nil)
-	_all.mutex.Lock()
-	_all.VariadicNoResultCalls = append(_all.VariadicNoResultCalls, _M0_VariadicNoResult_Call{P0})
-	var _fn func(...pkg.String)
-	if len(_dat.VariadicNoResultMocks) > 0 {
-		_fn = _dat.VariadicNoResultMocks[0]
-		if len(_dat.VariadicNoResultMocks) > 1 {
-			_dat.VariadicNoResultMocks = _dat.VariadicNoResultMocks[1:]
-		}
-	} else if len(_all.VariadicNoResultMocks) > 0 {
-		_fn = _all.VariadicNoResultMocks[0]
-		if len(_all.VariadicNoResultMocks) > 1 {
-			_all.VariadicNoResultMocks = _all.VariadicNoResultMocks[1:]
-		}
-	} else {
+	if _M0.Idle(&_M0Policy.VariadicNoResult) {
+		_recv.T0.VariadicNoResult(P0...)
+		return
+	}
+	_fn, _ok := mock.Next(_M0Entry(_recv), _M0.Global(), (*_M0Data)._VariadicNoResult, &_M0Policy.VariadicNoResult, _M0_VariadicNoResult_Call{P0})
+	if !_ok {
 		_fn = _recv.T0.VariadicNoResult
 	}
-	_dat.mutex.Unlock()
-	_all.mutex.Unlock()
 	_fn(P0...)
 }
 
 func (_recv *M0) _VariadicNoResult_Do(fn func(...pkg.String)) {
-	if _recv == nil {
-		panic("M0.VariadicNoResult: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.VariadicNoResultMocks = []func(...pkg.String){}
-	} else if len(_dat.VariadicNoResultMocks) < 2 {
-		_dat.VariadicNoResultMocks = []func(...pkg.String){fn, fn}
-	} else {
-		_dat.VariadicNoResultMocks = _dat.VariadicNoResultMocks[:len(_dat.VariadicNoResultMocks)-1]
-		_dat.VariadicNoResultMocks = append(_dat.VariadicNoResultMocks, fn)
-		_dat.VariadicNoResultMocks = append(_dat.VariadicNoResultMocks, fn)
-	}
-}
-
-func (M0) _VariadicNoResult_DoAll(t *testing.T, fn func(...pkg.String)) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.VariadicNoResultMocks = []func(...pkg.String){}
-	} else if len(_dat.VariadicNoResultMocks) < 2 {
-		_dat.VariadicNoResultMocks = []func(...pkg.String){fn, fn}
-	} else {
-		_dat.VariadicNoResultMocks = _dat.VariadicNoResultMocks[:len(_dat.VariadicNoResultMocks)-1]
-		_dat.VariadicNoResultMocks = append(_dat.VariadicNoResultMocks, fn)
-		_dat.VariadicNoResultMocks = append(_dat.VariadicNoResultMocks, fn)
-	}
-	_dat.once.Do(func() {
-		t.Cleanup(func() {
-			defer _dat.mutex.Unlock()
-			_dat.mutex.Lock()
-			_dat.VariadicNoResultMocks = []func(...pkg.String){}
-			_dat.once = sync.Once{}
-		})
-	})
+	mock.Do(_M0Instance(_recv, "M0.VariadicNoResult"), (*_M0Data)._VariadicNoResult, fn)
+}
+
+func (_recv *M0) _VariadicNoResult_DoT(t testing.TB, fn func(...pkg.String)) {
+	mock.DoT(t, _M0Instance(_recv, "M0.VariadicNoResult"), (*_M0Data)._VariadicNoResult, fn)
+}
+
+func (M0) _VariadicNoResult_DoAll(t testing.TB, fn func(...pkg.String)) {
+	t.Helper()
+	mock.Serial(t, "M0.VariadicNoResult")
+	mock.DoAll(t, _M0.Global(), (*_M0Data)._VariadicNoResult, fn)
 }
 
 func (_recv *M0) _VariadicNoResult_Stub() {
 	_recv._VariadicNoResult_Do(func(...pkg.String) { return })
 }
 
-func (M0) _VariadicNoResult_StubAll(t *testing.T) {
+func (_recv *M0) _VariadicNoResult_StubT(t testing.TB) {
+	_recv._VariadicNoResult_DoT(t, func(...pkg.String) { return })
+}
+
+func (M0) _VariadicNoResult_StubAll(t testing.TB) {
+	t.Helper()
 	new(M0)._VariadicNoResult_DoAll(t, func(...pkg.String) { return })
 }
 
@@ -2773,114 +2278,77 @@ func (_recv *M0) _VariadicNoResult_Return() {
 	_recv._VariadicNoResult_Do(func(...pkg.String) { return })
 }
 
-func (M0) _VariadicNoResult_ReturnAll(t *testing.T) {
+func (_recv *M0) _VariadicNoResult_ReturnT(t testing.TB) {
+	_recv._VariadicNoResult_DoT(t, func(...pkg.String) { return })
+}
+
+func (M0) _VariadicNoResult_ReturnAll(t testing.TB) {
+	t.Helper()
 	new(M0)._VariadicNoResult_DoAll(t, func(...pkg.String) { return })
 }
 
 func (_recv *M0) _VariadicNoResult_Calls() []_M0_VariadicNoResult_Call {
-	if _recv == nil {
-		panic("M0.VariadicNoResult: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.VariadicNoResultCalls
+	return mock.Calls(_M0Instance(_recv, "M0.VariadicNoResult"), (*_M0Data)._VariadicNoResult)
 }
 
 func (M0) _VariadicNoResult_AllCalls() []_M0_VariadicNoResult_Call {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.VariadicNoResultCalls
+	return mock.Calls(_M0.Global(), (*_M0Data)._VariadicNoResult)
+}
+
+func (M0) _VariadicNoResult_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M0.VariadicNoResult")
+	mock.Bubble(t, _M0.Global(), func(_dat *_M0Data) { _dat.VariadicNoResult.Calls.Clear() }, &_M0Policy.VariadicNoResult)
 }
 
-func (M0) _VariadicNoResult_BubbleCalls(t *testing.T) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	_dat.VariadicNoResultCalls = []_M0_VariadicNoResult_Call{}
-	t.Cleanup(func() {
-		defer _dat.mutex.Unlock()
-		_dat.mutex.Lock()
-		_dat.VariadicNoResultCalls = []_M0_VariadicNoResult_Call{}
-	})
+func (M0) _VariadicNoResult_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M0.VariadicNoResult")
+	_M0Policy.VariadicNoResult.Record(t, limit)
+}
+
+func (_dat *_M0Data) _VariadicOneResult() *mock.Method[func(...pkg.String) error, _M0_VariadicOneResult_Call] {
+	return &_dat.VariadicOneResult
 }
 
 func (_recv *M0) VariadicOneResult(P0 ...pkg.String) error {
 	if _recv == nil {
 		panic("M0.VariadicOneResult: nil pointer receiver")
 	}
-	_dat := _M0PtrData(_recv)
-	_dat.mutex.Lock()
-	_dat.VariadicOneResultCalls = append(_dat.VariadicOneResultCalls, _M0_VariadicOneResult_Call{P0})
-	_all := _M0PtrData(nil)
-	_all.mutex.Lock()
-	_all.VariadicOneResultCalls = append(_all.VariadicOneResultCalls, _M0_VariadicOneResult_Call{P0})
-	var _fn func(...pkg.String) error
-	if len(_dat.VariadicOneResultMocks) > 0 {
-		_fn = _dat.VariadicOneResultMocks[0]
-		if len(_dat.VariadicOneResultMocks) > 1 {
-			_dat.VariadicOneResultMocks = _dat.VariadicOneResultMocks[1:]
-		}
-	} else if len(_all.VariadicOneResultMocks) > 0 {
-		_fn = _all.VariadicOneResultMocks[0]
-		if len(_all.VariadicOneResultMocks) > 1 {
-			_all.VariadicOneResultMocks = _all.VariadicOneResultMocks[1:]
-		}
-	} else {
+	if _M0.Idle(&_M0Policy.VariadicOneResult) {
+		return _recv.T0.VariadicOneResult(P0...)
+	}
+	_fn, _ok := mock.Next(_M0Entry(_recv), _M0.Global(), (*_M0Data)._VariadicOneResult, &_M0Policy.VariadicOneResult, _M0_VariadicOneResult_Call{P0})
+	if !_ok {
 		_fn = _recv.T0.VariadicOneResult
 	}
-	_dat.mutex.Unlock()
-	_all.mutex.Unlock()
 	return _fn(P0...)
 }
 
 func (_recv *M0) _VariadicOneResult_Do(fn func(...pkg.String) error) {
-	if _recv == nil {
-		panic("M0.VariadicOneResult: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.VariadicOneResultMocks = []func(...pkg.String) error{}
-	} else if len(_dat.VariadicOneResultMocks) < 2 {
-		_dat.VariadicOneResultMocks = []func(...pkg.String) error{fn, fn}
-	} else {
-		_dat.VariadicOneResultMocks = _dat.VariadicOneResultMocks[:len(_dat.VariadicOneResultMocks)-1]
-		_dat.VariadicOneResultMocks = append(_dat.VariadicOneResultMocks, fn)
-		_dat.VariadicOneResultMocks = append(_dat.VariadicOneResultMocks, fn)
-	}
-}
-
-func (M0) _VariadicOneResult_DoAll(t *testing.T, fn func(...pkg.String) error) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.VariadicOneResultMocks = []func(...pkg.String) error{}
-	} else if len(_dat.VariadicOneResultMocks) < 2 {
-		_dat.VariadicOneResultMocks = []func(...pkg.String) error{fn, fn}
-	} else {
-		_dat.VariadicOneResultMocks = _dat.VariadicOneResultMocks[:len(_dat.VariadicOneResultMocks)-1]
-		_dat.VariadicOneResultMocks = append(_dat.VariadicOneResultMocks, fn)
-		_dat.VariadicOneResultMocks = append(_dat.VariadicOneResultMocks, fn)
-	}
-	_dat.once.Do(func() {
-		t.Cleanup(func() {
-			defer _dat.mutex.Unlock()
-			_dat.mutex.Lock()
-			_dat.VariadicOneResultMocks = []func(...pkg.String) error{}
-			_dat.once = sync.Once{}
-		})
-	})
+	mock.Do(_M0Instance(_recv, "M0.VariadicOneResult"), (*_M0Data)._VariadicOneResult, fn)
+}
+
+func (_recv *M0) _VariadicOneResult_DoT(t testing.TB, fn func(...pkg.String) error) {
+	mock.DoT(t, _M0Instance(_recv, "M0.VariadicOneResult"), (*_M0Data)._VariadicOneResult, fn)
+}
+
+func (M0) _VariadicOneResult_DoAll(t testing.TB, fn func(...pkg.String) error) {
+	t.Helper()
+	mock.Serial(t, "M0.VariadicOneResult")
+	mock.DoAll(t, _M0.Global(), (*_M0Data)._VariadicOneResult, fn)
 }
 
 func (_recv *M0) _VariadicOneResult_Stub() {
 	_recv._VariadicOneResult_Do(func(...pkg.String) (r0 error) { return })
 }
 
-func (M0) _VariadicOneResult_StubAll(t *testing.T) {
+func (_recv *M0) _VariadicOneResult_StubT(t testing.TB) {
+	_recv._VariadicOneResult_DoT(t, func(...pkg.String) (r0 error) { return })
+}
+
+func (M0) _VariadicOneResult_StubAll(t testing.TB) {
+	t.Helper()
 	new(M0)._VariadicOneResult_DoAll(t, func(...pkg.String) (r0 error) { return })
 }
 
@@ -2888,114 +2356,77 @@ func (_recv *M0) _VariadicOneResult_Return(r0 error) {
 	_recv._VariadicOneResult_Do(func(...pkg.String) error { return r0 })
 }
 
-func (M0) _VariadicOneResult_ReturnAll(t *testing.T, r0 error) {
+func (_recv *M0) _VariadicOneResult_ReturnT(t testing.TB, r0 error) {
+	_recv._VariadicOneResult_DoT(t, func(...pkg.String) error { return r0 })
+}
+
+func (M0) _VariadicOneResult_ReturnAll(t testing.TB, r0 error) {
+	t.Helper()
 	new(M0)._VariadicOneResult_DoAll(t, func(...pkg.String) error { return r0 })
 }
 
 func (_recv *M0) _VariadicOneResult_Calls() []_M0_VariadicOneResult_Call {
-	if _recv == nil {
-		panic("M0.VariadicOneResult: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.VariadicOneResultCalls
+	return mock.Calls(_M0Instance(_recv, "M0.VariadicOneResult"), (*_M0Data)._VariadicOneResult)
 }
 
 func (M0) _VariadicOneResult_AllCalls() []_M0_VariadicOneResult_Call {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.VariadicOneResultCalls
+	return mock.Calls(_M0.Global(), (*_M0Data)._VariadicOneResult)
+}
+
+func (M0) _VariadicOneResult_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M0.VariadicOneResult")
+	mock.Bubble(t, _M0.Global(), func(_dat *_M0Data) { _dat.VariadicOneResult.Calls.Clear() }, &_M0Policy.VariadicOneResult)
+}
+
+func (M0) _VariadicOneResult_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M0.VariadicOneResult")
+	_M0Policy.VariadicOneResult.Record(t, limit)
 }
 
-func (M0) _VariadicOneResult_BubbleCalls(t *testing.T) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	_dat.VariadicOneResultCalls = []_M0_VariadicOneResult_Call{}
-	t.Cleanup(func() {
-		defer _dat.mutex.Unlock()
-		_dat.mutex.Lock()
-		_dat.VariadicOneResultCalls = []_M0_VariadicOneResult_Call{}
-	})
+func (_dat *_M0Data) _VariadicTwoResults() *mock.Method[func(...pkg.String) (pkg.Int, error), _M0_VariadicTwoResults_Call] {
+	return &_dat.VariadicTwoResults
 }
 
 func (_recv *M0) VariadicTwoResults(P0 ...pkg.String) (pkg.Int, error) {
 	if _recv == nil {
 		panic("M0.VariadicTwoResults: nil pointer receiver")
 	}
-	_dat := _M0PtrData(_recv)
-	_dat.mutex.Lock()
-	_dat.VariadicTwoResultsCalls = append(_dat.VariadicTwoResultsCalls, _M0_VariadicTwoResults_Call{P0})
-	_all := _M0PtrData(nil)
-	_all.mutex.Lock()
-	_all.VariadicTwoResultsCalls = append(_all.VariadicTwoResultsCalls, _M0_VariadicTwoResults_Call{P0})
-	var _fn func(...pkg.String) (pkg.Int, error)
-	if len(_dat.VariadicTwoResultsMocks) > 0 {
-		_fn = _dat.VariadicTwoResultsMocks[0]
-		if len(_dat.VariadicTwoResultsMocks) > 1 {
-			_dat.VariadicTwoResultsMocks = _dat.VariadicTwoResultsMocks[1:]
-		}
-	} else if len(_all.VariadicTwoResultsMocks) > 0 {
-		_fn = _all.VariadicTwoResultsMocks[0]
-		if len(_all.VariadicTwoResultsMocks) > 1 {
-			_all.VariadicTwoResultsMocks = _all.VariadicTwoResultsMocks[1:]
-		}
-	} else {
+	if _M0.Idle(&_M0Policy.VariadicTwoResults) {
+		return _recv.T0.VariadicTwoResults(P0...)
+	}
+	_fn, _ok := mock.Next(_M0Entry(_recv), _M0.Global(), (*_M0Data)._VariadicTwoResults, &_M0Policy.VariadicTwoResults, _M0_VariadicTwoResults_Call{P0})
+	if !_ok {
 		_fn = _recv.T0.VariadicTwoResults
 	}
-	_dat.mutex.Unlock()
-	_all.mutex.Unlock()
 	return _fn(P0...)
 }
 
 func (_recv *M0) _VariadicTwoResults_Do(fn func(...pkg.String) (pkg.Int, error)) {
-	if _recv == nil {
-		panic("M0.VariadicTwoResults: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.VariadicTwoResultsMocks = []func(...pkg.String) (pkg.Int, error){}
-	} else if len(_dat.VariadicTwoResultsMocks) < 2 {
-		_dat.VariadicTwoResultsMocks = []func(...pkg.String) (pkg.Int, error){fn, fn}
-	} else {
-		_dat.VariadicTwoResultsMocks = _dat.VariadicTwoResultsMocks[:len(_dat.VariadicTwoResultsMocks)-1]
-		_dat.VariadicTwoResultsMocks = append(_dat.VariadicTwoResultsMocks, fn)
-		_dat.VariadicTwoResultsMocks = append(_dat.VariadicTwoResultsMocks, fn)
-	}
-}
-
-func (M0) _VariadicTwoResults_DoAll(t *testing.T, fn func(...pkg.String) (pkg.Int, error)) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.VariadicTwoResultsMocks = []func(...pkg.String) (pkg.Int, error){}
-	} else if len(_dat.VariadicTwoResultsMocks) < 2 {
-		_dat.VariadicTwoResultsMocks = []func(...pkg.String) (pkg.Int, error){fn, fn}
-	} else {
-		_dat.VariadicTwoResultsMocks = _dat.VariadicTwoResultsMocks[:len(_dat.VariadicTwoResultsMocks)-1]
-		_dat.VariadicTwoResultsMocks = append(_dat.VariadicTwoResultsMocks, fn)
-		_dat.VariadicTwoResultsMocks = append(_dat.VariadicTwoResultsMocks, fn)
-	}
-	_dat.once.Do(func() {
-		t.Cleanup(func() {
-			defer _dat.mutex.Unlock()
-			_dat.mutex.Lock()
-			_dat.VariadicTwoResultsMocks = []func(...pkg.String) (pkg.Int, error){}
-			_dat.once = sync.Once{}
-		})
-	})
+	mock.Do(_M0Instance(_recv, "M0.VariadicTwoResults"), (*_M0Data)._VariadicTwoResults, fn)
+}
+
+func (_recv *M0) _VariadicTwoResults_DoT(t testing.TB, fn func(...pkg.String) (pkg.Int, error)) {
+	mock.DoT(t, _M0Instance(_recv, "M0.VariadicTwoResults"), (*_M0Data)._VariadicTwoResults, fn)
+}
+
+func (M0) _VariadicTwoResults_DoAll(t testing.TB, fn func(...pkg.String) (pkg.Int, error)) {
+	t.Helper()
+	mock.Serial(t, "M0.VariadicTwoResults")
+	mock.DoAll(t, _M0.Global(), (*_M0Data)._VariadicTwoResults, fn)
 }
 
 func (_recv *M0) _VariadicTwoResults_Stub() {
 	_recv._VariadicTwoResults_Do(func(...pkg.String) (r0 pkg.Int, r1 error) { return })
 }
 
-func (M0) _VariadicTwoResults_StubAll(t *testing.T) {
+func (_recv *M0) _VariadicTwoResults_StubT(t testing.TB) {
+	_recv._VariadicTwoResults_DoT(t, func(...pkg.String) (r0 pkg.Int, r1 error) { return })
+}
+
+func (M0) _VariadicTwoResults_StubAll(t testing.TB) {
+	t.Helper()
 	new(M0)._VariadicTwoResults_DoAll(t, func(...pkg.String) (r0 pkg.Int, r1 error) { return })
 }
 
@@ -3003,114 +2434,77 @@ func (_recv *M0) _VariadicTwoResults_Return(r0 pkg.Int, r1 error) {
 	_recv._VariadicTwoResults_Do(func(...pkg.String) (pkg.Int, error) { return r0, r1 })
 }
 
-func (M0) _VariadicTwoResults_ReturnAll(t *testing.T, r0 pkg.Int, r1 error) {
+func (_recv *M0) _VariadicTwoResults_ReturnT(t testing.TB, r0 pkg.Int, r1 error) {
+	_recv._VariadicTwoResults_DoT(t, func(...pkg.String) (pkg.Int, error) { return r0, r1 })
+}
+
+func (M0) _VariadicTwoResults_ReturnAll(t testing.TB, r0 pkg.Int, r1 error) {
+	t.Helper()
 	new(M0)._VariadicTwoResults_DoAll(t, func(...pkg.String) (pkg.Int, error) { return r0, r1 })
 }
 
 func (_recv *M0) _VariadicTwoResults_Calls() []_M0_VariadicTwoResults_Call {
-	if _recv == nil {
-		panic("M0.VariadicTwoResults: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.VariadicTwoResultsCalls
+	return mock.Calls(_M0Instance(_recv, "M0.VariadicTwoResults"), (*_M0Data)._VariadicTwoResults)
 }
 
 func (M0) _VariadicTwoResults_AllCalls() []_M0_VariadicTwoResults_Call {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.VariadicTwoResultsCalls
+	return mock.Calls(_M0.Global(), (*_M0Data)._VariadicTwoResults)
+}
+
+func (M0) _VariadicTwoResults_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M0.VariadicTwoResults")
+	mock.Bubble(t, _M0.Global(), func(_dat *_M0Data) { _dat.VariadicTwoResults.Calls.Clear() }, &_M0Policy.VariadicTwoResults)
+}
+
+func (M0) _VariadicTwoResults_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M0.VariadicTwoResults")
+	_M0Policy.VariadicTwoResults.Record(t, limit)
 }
 
-func (M0) _VariadicTwoResults_BubbleCalls(t *testing.T) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	_dat.VariadicTwoResultsCalls = []_M0_VariadicTwoResults_Call{}
-	t.Cleanup(func() {
-		defer _dat.mutex.Unlock()
-		_dat.mutex.Lock()
-		_dat.VariadicTwoResultsCalls = []_M0_VariadicTwoResults_Call{}
-	})
+func (_dat *_M0Data) _Write() *mock.Method[func([]byte) (int, error), _M0_Write_Call] {
+	return &_dat.Write
 }
 
 func (_recv *M0) Write(p []byte) (int, error) {
 	if _recv == nil {
 		panic("M0.Write: nil pointer receiver")
 	}
-	_dat := _M0PtrData(_recv)
-	_dat.mutex.Lock()
-	_dat.WriteCalls = append(_dat.WriteCalls, _M0_Write_Call{p})
-	_all := _M0PtrData(nil)
-	_all.mutex.Lock()
-	_all.WriteCalls = append(_all.WriteCalls, _M0_Write_Call{p})
-	var _fn func([]byte) (int, error)
-	if len(_dat.WriteMocks) > 0 {
-		_fn = _dat.WriteMocks[0]
-		if len(_dat.WriteMocks) > 1 {
-			_dat.WriteMocks = _dat.WriteMocks[1:]
-		}
-	} else if len(_all.WriteMocks) > 0 {
-		_fn = _all.WriteMocks[0]
-		if len(_all.WriteMocks) > 1 {
-			_all.WriteMocks = _all.WriteMocks[1:]
-		}
-	} else {
+	if _M0.Idle(&_M0Policy.Write) {
+		return _recv.T0.Write(p)
+	}
+	_fn, _ok := mock.Next(_M0Entry(_recv), _M0.Global(), (*_M0Data)._Write, &_M0Policy.Write, _M0_Write_Call{p})
+	if !_ok {
 		_fn = _recv.T0.Write
 	}
-	_dat.mutex.Unlock()
-	_all.mutex.Unlock()
 	return _fn(p)
 }
 
 func (_recv *M0) _Write_Do(fn func([]byte) (int, error)) {
-	if _recv == nil {
-		panic("M0.Write: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.WriteMocks = []func([]byte) (int, error){}
-	} else if len(_dat.WriteMocks) < 2 {
-		_dat.WriteMocks = []func([]byte) (int, error){fn, fn}
-	} else {
-		_dat.WriteMocks = _dat.WriteMocks[:len(_dat.WriteMocks)-1]
-		_dat.WriteMocks = append(_dat.WriteMocks, fn)
-		_dat.WriteMocks = append(_dat.WriteMocks, fn)
-	}
-}
-
-func (M0) _Write_DoAll(t *testing.T, fn func([]byte) (int, error)) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	if fn == nil {
-		_dat.WriteMocks = []func([]byte) (int, error){}
-	} else if len(_dat.WriteMocks) < 2 {
-		_dat.WriteMocks = []func([]byte) (int, error){fn, fn}
-	} else {
-		_dat.WriteMocks = _dat.WriteMocks[:len(_dat.WriteMocks)-1]
-		_dat.WriteMocks = append(_dat.WriteMocks, fn)
-		_dat.WriteMocks = append(_dat.WriteMocks, fn)
-	}
-	_dat.once.Do(func() {
-		t.Cleanup(func() {
-			defer _dat.mutex.Unlock()
-			_dat.mutex.Lock()
-			_dat.WriteMocks = []func([]byte) (int, error){}
-			_dat.once = sync.Once{}
-		})
-	})
+	mock.Do(_M0Instance(_recv, "M0.Write"), (*_M0Data)._Write, fn)
+}
+
+func (_recv *M0) _Write_DoT(t testing.TB, fn func([]byte) (int, error)) {
+	mock.DoT(t, _M0Instance(_recv, "M0.Write"), (*_M0Data)._Write, fn)
+}
+
+func (M0) _Write_DoAll(t testing.TB, fn func([]byte) (int, error)) {
+	t.Helper()
+	mock.Serial(t, "M0.Write")
+	mock.DoAll(t, _M0.Global(), (*_M0Data)._Write, fn)
 }
 
 func (_recv *M0) _Write_Stub() {
 	_recv._Write_Do(func([]byte) (n int, err error) { return })
 }
 
-func (M0) _Write_StubAll(t *testing.T) {
+func (_recv *M0) _Write_StubT(t testing.TB) {
+	_recv._Write_DoT(t, func([]byte) (n int, err error) { return })
+}
+
+func (M0) _Write_StubAll(t testing.TB) {
+	t.Helper()
 	new(M0)._Write_DoAll(t, func([]byte) (n int, err error) { return })
 }
 
@@ -3118,35 +2512,2147 @@ func (_recv *M0) _Write_Return(n int, err error) {
 	_recv._Write_Do(func([]byte) (int, error) { return n, err })
 }
 
-func (M0) _Write_ReturnAll(t *testing.T, n int, err error) {
+func (_recv *M0) _Write_ReturnT(t testing.TB, n int, err error) {
+	_recv._Write_DoT(t, func([]byte) (int, error) { return n, err })
+}
+
+func (M0) _Write_ReturnAll(t testing.TB, n int, err error) {
+	t.Helper()
 	new(M0)._Write_DoAll(t, func([]byte) (int, error) { return n, err })
 }
 
 func (_recv *M0) _Write_Calls() []_M0_Write_Call {
-	if _recv == nil {
-		panic("M0.Write: nil pointer receiver")
-	}
-	_dat := _M0PtrData(_recv)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.WriteCalls
+	return mock.Calls(_M0Instance(_recv, "M0.Write"), (*_M0Data)._Write)
 }
 
 func (M0) _Write_AllCalls() []_M0_Write_Call {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	return _dat.WriteCalls
-}
-
-func (M0) _Write_BubbleCalls(t *testing.T) {
-	_dat := _M0PtrData(nil)
-	defer _dat.mutex.Unlock()
-	_dat.mutex.Lock()
-	_dat.WriteCalls = []_M0_Write_Call{}
-	t.Cleanup(func() {
-		defer _dat.mutex.Unlock()
-		_dat.mutex.Lock()
-		_dat.WriteCalls = []_M0_Write_Call{}
-	})
+	return mock.Calls(_M0.Global(), (*_M0Data)._Write)
+}
+
+func (M0) _Write_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M0.Write")
+	mock.Bubble(t, _M0.Global(), func(_dat *_M0Data) { _dat.Write.Calls.Clear() }, &_M0Policy.Write)
+}
+
+func (M0) _Write_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M0.Write")
+	_M0Policy.Write.Record(t, limit)
+}
+
+type _M0_ContextNoResult_CtxKey struct{}
+
+func (M0) _ContextNoResult_DoCtx(ctx context.Context, fn func(context.Context)) context.Context {
+	return mock.WithContext(ctx, _M0_ContextNoResult_CtxKey{}, fn)
+}
+
+func (M0) _ContextNoResult_StubCtx(ctx context.Context) context.Context {
+	return new(M0)._ContextNoResult_DoCtx(ctx, func(context.Context) { return })
+}
+
+func (M0) _ContextNoResult_ReturnCtx(ctx context.Context) context.Context {
+	return new(M0)._ContextNoResult_DoCtx(ctx, func(context.Context) { return })
+}
+
+type _M0_ContextOneResult_CtxKey struct{}
+
+func (M0) _ContextOneResult_DoCtx(ctx context.Context, fn func(context.Context, pkg.String) error) context.Context {
+	return mock.WithContext(ctx, _M0_ContextOneResult_CtxKey{}, fn)
+}
+
+func (M0) _ContextOneResult_StubCtx(ctx context.Context) context.Context {
+	return new(M0)._ContextOneResult_DoCtx(ctx, func(context.Context, pkg.String) (r0 error) { return })
+}
+
+func (M0) _ContextOneResult_ReturnCtx(ctx context.Context, r0 error) context.Context {
+	return new(M0)._ContextOneResult_DoCtx(ctx, func(context.Context, pkg.String) error { return r0 })
+}
+
+type _M0_ContextTwoResults_CtxKey struct{}
+
+func (M0) _ContextTwoResults_DoCtx(ctx context.Context, fn func(context.Context) (pkg.Int, error)) context.Context {
+	return mock.WithContext(ctx, _M0_ContextTwoResults_CtxKey{}, fn)
+}
+
+func (M0) _ContextTwoResults_StubCtx(ctx context.Context) context.Context {
+	return new(M0)._ContextTwoResults_DoCtx(ctx, func(context.Context) (r0 pkg.Int, r1 error) { return })
+}
+
+func (M0) _ContextTwoResults_ReturnCtx(ctx context.Context, r0 pkg.Int, r1 error) context.Context {
+	return new(M0)._ContextTwoResults_DoCtx(ctx, func(context.Context) (pkg.Int, error) { return r0, r1 })
+}
+
+type _M0Mock struct {
+	AllNamedIdentifiers  _M0_AllNamedIdentifiers_Mock
+	ContextNoResult      _M0_ContextNoResult_Mock
+	ContextOneResult     _M0_ContextOneResult_Mock
+	ContextTwoResults    _M0_ContextTwoResults_Mock
+	MixedNoResult        _M0_MixedNoResult_Mock
+	MixedOneResult       _M0_MixedOneResult_Mock
+	MixedTwoResults      _M0_MixedTwoResults_Mock
+	NamedMixedNoResult   _M0_NamedMixedNoResult_Mock
+	NamedMixedOneResult  _M0_NamedMixedOneResult_Mock
+	NamedMixedTwoResults _M0_NamedMixedTwoResults_Mock
+	NamedParamNoResult   _M0_NamedParamNoResult_Mock
+	NamedParamOneResult  _M0_NamedParamOneResult_Mock
+	NamedParamTwoResults _M0_NamedParamTwoResults_Mock
+	OneNamedResult       _M0_OneNamedResult_Mock
+	OneParamNoResult     _M0_OneParamNoResult_Mock
+	OneParamOneResult    _M0_OneParamOneResult_Mock
+	OneParamTwoResults   _M0_OneParamTwoResults_Mock
+	OneResult            _M0_OneResult_Mock
+	Read                 _M0_Read_Mock
+	Simple               _M0_Simple_Mock
+	TwoNamedResults      _M0_TwoNamedResults_Mock
+	TwoParamsNoResult    _M0_TwoParamsNoResult_Mock
+	TwoParamsOneResult   _M0_TwoParamsOneResult_Mock
+	TwoParamsTwoResults  _M0_TwoParamsTwoResults_Mock
+	TwoResults           _M0_TwoResults_Mock
+	VariadicNoResult     _M0_VariadicNoResult_Mock
+	VariadicOneResult    _M0_VariadicOneResult_Mock
+	VariadicTwoResults   _M0_VariadicTwoResults_Mock
+	Write                _M0_Write_Mock
+}
+
+func (_recv *M0) Mock() *_M0Mock {
+	return &_M0Mock{
+		AllNamedIdentifiers:  _M0_AllNamedIdentifiers_Mock{_recv},
+		ContextNoResult:      _M0_ContextNoResult_Mock{_recv},
+		ContextOneResult:     _M0_ContextOneResult_Mock{_recv},
+		ContextTwoResults:    _M0_ContextTwoResults_Mock{_recv},
+		MixedNoResult:        _M0_MixedNoResult_Mock{_recv},
+		MixedOneResult:       _M0_MixedOneResult_Mock{_recv},
+		MixedTwoResults:      _M0_MixedTwoResults_Mock{_recv},
+		NamedMixedNoResult:   _M0_NamedMixedNoResult_Mock{_recv},
+		NamedMixedOneResult:  _M0_NamedMixedOneResult_Mock{_recv},
+		NamedMixedTwoResults: _M0_NamedMixedTwoResults_Mock{_recv},
+		NamedParamNoResult:   _M0_NamedParamNoResult_Mock{_recv},
+		NamedParamOneResult:  _M0_NamedParamOneResult_Mock{_recv},
+		NamedParamTwoResults: _M0_NamedParamTwoResults_Mock{_recv},
+		OneNamedResult:       _M0_OneNamedResult_Mock{_recv},
+		OneParamNoResult:     _M0_OneParamNoResult_Mock{_recv},
+		OneParamOneResult:    _M0_OneParamOneResult_Mock{_recv},
+		OneParamTwoResults:   _M0_OneParamTwoResults_Mock{_recv},
+		OneResult:            _M0_OneResult_Mock{_recv},
+		Read:                 _M0_Read_Mock{_recv},
+		Simple:               _M0_Simple_Mock{_recv},
+		TwoNamedResults:      _M0_TwoNamedResults_Mock{_recv},
+		TwoParamsNoResult:    _M0_TwoParamsNoResult_Mock{_recv},
+		TwoParamsOneResult:   _M0_TwoParamsOneResult_Mock{_recv},
+		TwoParamsTwoResults:  _M0_TwoParamsTwoResults_Mock{_recv},
+		TwoResults:           _M0_TwoResults_Mock{_recv},
+		VariadicNoResult:     _M0_VariadicNoResult_Mock{_recv},
+		VariadicOneResult:    _M0_VariadicOneResult_Mock{_recv},
+		VariadicTwoResults:   _M0_VariadicTwoResults_Mock{_recv},
+		Write:                _M0_Write_Mock{_recv},
+	}
+}
+
+type _M0_AllNamedIdentifiers_Mock struct{ _recv *M0 }
+
+func (_m _M0_AllNamedIdentifiers_Mock) Do(fn func(pkg.String, ...pkg.String) (pkg.Int, error)) {
+	_m._recv._AllNamedIdentifiers_Do(fn)
+}
+
+func (_m _M0_AllNamedIdentifiers_Mock) DoT(t testing.TB, fn func(pkg.String, ...pkg.String) (pkg.Int, error)) {
+	t.Helper()
+	_m._recv._AllNamedIdentifiers_DoT(t, fn)
+}
+
+func (_m _M0_AllNamedIdentifiers_Mock) Stub() {
+	_m._recv._AllNamedIdentifiers_Stub()
+}
+
+func (_m _M0_AllNamedIdentifiers_Mock) StubT(t testing.TB) {
+	t.Helper()
+	_m._recv._AllNamedIdentifiers_StubT(t)
+}
+
+func (_m _M0_AllNamedIdentifiers_Mock) Return(n pkg.Int, err error) {
+	_m._recv._AllNamedIdentifiers_Return(n, err)
+}
+
+func (_m _M0_AllNamedIdentifiers_Mock) ReturnT(t testing.TB, n pkg.Int, err error) {
+	t.Helper()
+	_m._recv._AllNamedIdentifiers_ReturnT(t, n, err)
+}
+
+func (_m _M0_AllNamedIdentifiers_Mock) Calls() []_M0_AllNamedIdentifiers_Call {
+	return _m._recv._AllNamedIdentifiers_Calls()
+}
+
+func (_m _M0_AllNamedIdentifiers_Mock) All(t testing.TB) _M0_AllNamedIdentifiers_AllMock {
+	return _M0_AllNamedIdentifiers_AllMock{t}
+}
+
+type _M0_AllNamedIdentifiers_AllMock struct{ t testing.TB }
+
+func (_m _M0_AllNamedIdentifiers_AllMock) Do(fn func(pkg.String, ...pkg.String) (pkg.Int, error)) {
+	_m.t.Helper()
+	new(M0)._AllNamedIdentifiers_DoAll(_m.t, fn)
+}
+
+func (_m _M0_AllNamedIdentifiers_AllMock) Stub() {
+	_m.t.Helper()
+	new(M0)._AllNamedIdentifiers_StubAll(_m.t)
+}
+
+func (_m _M0_AllNamedIdentifiers_AllMock) Return(n pkg.Int, err error) {
+	_m.t.Helper()
+	new(M0)._AllNamedIdentifiers_ReturnAll(_m.t, n, err)
+}
+
+func (_m _M0_AllNamedIdentifiers_AllMock) Calls() []_M0_AllNamedIdentifiers_Call {
+	return new(M0)._AllNamedIdentifiers_AllCalls()
+}
+
+func (_m _M0_AllNamedIdentifiers_AllMock) Bubble() {
+	_m.t.Helper()
+	new(M0)._AllNamedIdentifiers_BubbleCalls(_m.t)
+}
+
+func (_m _M0_AllNamedIdentifiers_AllMock) Record(limit int) {
+	_m.t.Helper()
+	new(M0)._AllNamedIdentifiers_Record(_m.t, limit)
+}
+
+type _M0_ContextNoResult_Mock struct{ _recv *M0 }
+
+func (_m _M0_ContextNoResult_Mock) Do(fn func(context.Context)) {
+	_m._recv._ContextNoResult_Do(fn)
+}
+
+func (_m _M0_ContextNoResult_Mock) DoT(t testing.TB, fn func(context.Context)) {
+	t.Helper()
+	_m._recv._ContextNoResult_DoT(t, fn)
+}
+
+func (_m _M0_ContextNoResult_Mock) Stub() {
+	_m._recv._ContextNoResult_Stub()
+}
+
+func (_m _M0_ContextNoResult_Mock) StubT(t testing.TB) {
+	t.Helper()
+	_m._recv._ContextNoResult_StubT(t)
+}
+
+func (_m _M0_ContextNoResult_Mock) Return() {
+	_m._recv._ContextNoResult_Return()
+}
+
+func (_m _M0_ContextNoResult_Mock) ReturnT(t testing.TB) {
+	t.Helper()
+	_m._recv._ContextNoResult_ReturnT(t)
+}
+
+func (_m _M0_ContextNoResult_Mock) Calls() []_M0_ContextNoResult_Call {
+	return _m._recv._ContextNoResult_Calls()
+}
+
+func (_m _M0_ContextNoResult_Mock) All(t testing.TB) _M0_ContextNoResult_AllMock {
+	return _M0_ContextNoResult_AllMock{t}
+}
+
+type _M0_ContextNoResult_AllMock struct{ t testing.TB }
+
+func (_m _M0_ContextNoResult_AllMock) Do(fn func(context.Context)) {
+	_m.t.Helper()
+	new(M0)._ContextNoResult_DoAll(_m.t, fn)
+}
+
+func (_m _M0_ContextNoResult_AllMock) Stub() {
+	_m.t.Helper()
+	new(M0)._ContextNoResult_StubAll(_m.t)
+}
+
+func (_m _M0_ContextNoResult_AllMock) Return() {
+	_m.t.Helper()
+	new(M0)._ContextNoResult_ReturnAll(_m.t)
+}
+
+func (_m _M0_ContextNoResult_AllMock) Calls() []_M0_ContextNoResult_Call {
+	return new(M0)._ContextNoResult_AllCalls()
+}
+
+func (_m _M0_ContextNoResult_AllMock) Bubble() {
+	_m.t.Helper()
+	new(M0)._ContextNoResult_BubbleCalls(_m.t)
+}
+
+func (_m _M0_ContextNoResult_AllMock) Record(limit int) {
+	_m.t.Helper()
+	new(M0)._ContextNoResult_Record(_m.t, limit)
+}
+
+func (_m _M0_ContextNoResult_Mock) DoCtx(ctx context.Context, fn func(context.Context)) context.Context {
+	return new(M0)._ContextNoResult_DoCtx(ctx, fn)
+}
+
+func (_m _M0_ContextNoResult_Mock) StubCtx(ctx context.Context) context.Context {
+	return new(M0)._ContextNoResult_StubCtx(ctx)
+}
+
+func (_m _M0_ContextNoResult_Mock) ReturnCtx(ctx context.Context) context.Context {
+	return new(M0)._ContextNoResult_ReturnCtx(ctx)
+}
+
+type _M0_ContextOneResult_Mock struct{ _recv *M0 }
+
+func (_m _M0_ContextOneResult_Mock) Do(fn func(context.Context, pkg.String) error) {
+	_m._recv._ContextOneResult_Do(fn)
+}
+
+func (_m _M0_ContextOneResult_Mock) DoT(t testing.TB, fn func(context.Context, pkg.String) error) {
+	t.Helper()
+	_m._recv._ContextOneResult_DoT(t, fn)
+}
+
+func (_m _M0_ContextOneResult_Mock) Stub() {
+	_m._recv._ContextOneResult_Stub()
+}
+
+func (_m _M0_ContextOneResult_Mock) StubT(t testing.TB) {
+	t.Helper()
+	_m._recv._ContextOneResult_StubT(t)
+}
+
+func (_m _M0_ContextOneResult_Mock) Return(r0 error) {
+	_m._recv._ContextOneResult_Return(r0)
+}
+
+func (_m _M0_ContextOneResult_Mock) ReturnT(t testing.TB, r0 error) {
+	t.Helper()
+	_m._recv._ContextOneResult_ReturnT(t, r0)
+}
+
+func (_m _M0_ContextOneResult_Mock) Calls() []_M0_ContextOneResult_Call {
+	return _m._recv._ContextOneResult_Calls()
+}
+
+func (_m _M0_ContextOneResult_Mock) All(t testing.TB) _M0_ContextOneResult_AllMock {
+	return _M0_ContextOneResult_AllMock{t}
+}
+
+type _M0_ContextOneResult_AllMock struct{ t testing.TB }
+
+func (_m _M0_ContextOneResult_AllMock) Do(fn func(context.Context, pkg.String) error) {
+	_m.t.Helper()
+	new(M0)._ContextOneResult_DoAll(_m.t, fn)
+}
+
+func (_m _M0_ContextOneResult_AllMock) Stub() {
+	_m.t.Helper()
+	new(M0)._ContextOneResult_StubAll(_m.t)
+}
+
+func (_m _M0_ContextOneResult_AllMock) Return(r0 error) {
+	_m.t.Helper()
+	new(M0)._ContextOneResult_ReturnAll(_m.t, r0)
+}
+
+func (_m _M0_ContextOneResult_AllMock) Calls() []_M0_ContextOneResult_Call {
+	return new(M0)._ContextOneResult_AllCalls()
+}
+
+func (_m _M0_ContextOneResult_AllMock) Bubble() {
+	_m.t.Helper()
+	new(M0)._ContextOneResult_BubbleCalls(_m.t)
+}
+
+func (_m _M0_ContextOneResult_AllMock) Record(limit int) {
+	_m.t.Helper()
+	new(M0)._ContextOneResult_Record(_m.t, limit)
+}
+
+func (_m _M0_ContextOneResult_Mock) DoCtx(ctx context.Context, fn func(context.Context, pkg.String) error) context.Context {
+	return new(M0)._ContextOneResult_DoCtx(ctx, fn)
+}
+
+func (_m _M0_ContextOneResult_Mock) StubCtx(ctx context.Context) context.Context {
+	return new(M0)._ContextOneResult_StubCtx(ctx)
+}
+
+func (_m _M0_ContextOneResult_Mock) ReturnCtx(ctx context.Context, r0 error) context.Context {
+	return new(M0)._ContextOneResult_ReturnCtx(ctx, r0)
+}
+
+type _M0_ContextTwoResults_Mock struct{ _recv *M0 }
+
+func (_m _M0_ContextTwoResults_Mock) Do(fn func(context.Context) (pkg.Int, error)) {
+	_m._recv._ContextTwoResults_Do(fn)
+}
+
+func (_m _M0_ContextTwoResults_Mock) DoT(t testing.TB, fn func(context.Context) (pkg.Int, error)) {
+	t.Helper()
+	_m._recv._ContextTwoResults_DoT(t, fn)
+}
+
+func (_m _M0_ContextTwoResults_Mock) Stub() {
+	_m._recv._ContextTwoResults_Stub()
+}
+
+func (_m _M0_ContextTwoResults_Mock) StubT(t testing.TB) {
+	t.Helper()
+	_m._recv._ContextTwoResults_StubT(t)
+}
+
+func (_m _M0_ContextTwoResults_Mock) Return(r0 pkg.Int, r1 error) {
+	_m._recv._ContextTwoResults_Return(r0, r1)
+}
+
+func (_m _M0_ContextTwoResults_Mock) ReturnT(t testing.TB, r0 pkg.Int, r1 error) {
+	t.Helper()
+	_m._recv._ContextTwoResults_ReturnT(t, r0, r1)
+}
+
+func (_m _M0_ContextTwoResults_Mock) Calls() []_M0_ContextTwoResults_Call {
+	return _m._recv._ContextTwoResults_Calls()
+}
+
+func (_m _M0_ContextTwoResults_Mock) All(t testing.TB) _M0_ContextTwoResults_AllMock {
+	return _M0_ContextTwoResults_AllMock{t}
+}
+
+type _M0_ContextTwoResults_AllMock struct{ t testing.TB }
+
+func (_m _M0_ContextTwoResults_AllMock) Do(fn func(context.Context) (pkg.Int, error)) {
+	_m.t.Helper()
+	new(M0)._ContextTwoResults_DoAll(_m.t, fn)
+}
+
+func (_m _M0_ContextTwoResults_AllMock) Stub() {
+	_m.t.Helper()
+	new(M0)._ContextTwoResults_StubAll(_m.t)
+}
+
+func (_m _M0_ContextTwoResults_AllMock) Return(r0 pkg.Int, r1 error) {
+	_m.t.Helper()
+	new(M0)._ContextTwoResults_ReturnAll(_m.t, r0, r1)
+}
+
+func (_m _M0_ContextTwoResults_AllMock) Calls() []_M0_ContextTwoResults_Call {
+	return new(M0)._ContextTwoResults_AllCalls()
+}
+
+func (_m _M0_ContextTwoResults_AllMock) Bubble() {
+	_m.t.Helper()
+	new(M0)._ContextTwoResults_BubbleCalls(_m.t)
+}
+
+func (_m _M0_ContextTwoResults_AllMock) Record(limit int) {
+	_m.t.Helper()
+	new(M0)._ContextTwoResults_Record(_m.t, limit)
+}
+
+func (_m _M0_ContextTwoResults_Mock) DoCtx(ctx context.Context, fn func(context.Context) (pkg.Int, error)) context.Context {
+	return new(M0)._ContextTwoResults_DoCtx(ctx, fn)
+}
+
+func (_m _M0_ContextTwoResults_Mock) StubCtx(ctx context.Context) context.Context {
+	return new(M0)._ContextTwoResults_StubCtx(ctx)
+}
+
+func (_m _M0_ContextTwoResults_Mock) ReturnCtx(ctx context.Context, r0 pkg.Int, r1 error) context.Context {
+	return new(M0)._ContextTwoResults_ReturnCtx(ctx, r0, r1)
+}
+
+type _M0_MixedNoResult_Mock struct{ _recv *M0 }
+
+func (_m _M0_MixedNoResult_Mock) Do(fn func(pkg.String, ...pkg.String)) {
+	_m._recv._MixedNoResult_Do(fn)
+}
+
+func (_m _M0_MixedNoResult_Mock) DoT(t testing.TB, fn func(pkg.String, ...pkg.String)) {
+	t.Helper()
+	_m._recv._MixedNoResult_DoT(t, fn)
+}
+
+func (_m _M0_MixedNoResult_Mock) Stub() {
+	_m._recv._MixedNoResult_Stub()
+}
+
+func (_m _M0_MixedNoResult_Mock) StubT(t testing.TB) {
+	t.Helper()
+	_m._recv._MixedNoResult_StubT(t)
+}
+
+func (_m _M0_MixedNoResult_Mock) Return() {
+	_m._recv._MixedNoResult_Return()
+}
+
+func (_m _M0_MixedNoResult_Mock) ReturnT(t testing.TB) {
+	t.Helper()
+	_m._recv._MixedNoResult_ReturnT(t)
+}
+
+func (_m _M0_MixedNoResult_Mock) Calls() []_M0_MixedNoResult_Call {
+	return _m._recv._MixedNoResult_Calls()
+}
+
+func (_m _M0_MixedNoResult_Mock) All(t testing.TB) _M0_MixedNoResult_AllMock {
+	return _M0_MixedNoResult_AllMock{t}
+}
+
+type _M0_MixedNoResult_AllMock struct{ t testing.TB }
+
+func (_m _M0_MixedNoResult_AllMock) Do(fn func(pkg.String, ...pkg.String)) {
+	_m.t.Helper()
+	new(M0)._MixedNoResult_DoAll(_m.t, fn)
+}
+
+func (_m _M0_MixedNoResult_AllMock) Stub() {
+	_m.t.Helper()
+	new(M0)._MixedNoResult_StubAll(_m.t)
+}
+
+func (_m _M0_MixedNoResult_AllMock) Return() {
+	_m.t.Helper()
+	new(M0)._MixedNoResult_ReturnAll(_m.t)
+}
+
+func (_m _M0_MixedNoResult_AllMock) Calls() []_M0_MixedNoResult_Call {
+	return new(M0)._MixedNoResult_AllCalls()
+}
+
+func (_m _M0_MixedNoResult_AllMock) Bubble() {
+	_m.t.Helper()
+	new(M0)._MixedNoResult_BubbleCalls(_m.t)
+}
+
+func (_m _M0_MixedNoResult_AllMock) Record(limit int) {
+	_m.t.Helper()
+	new(M0)._MixedNoResult_Record(_m.t, limit)
+}
+
+type _M0_MixedOneResult_Mock struct{ _recv *M0 }
+
+func (_m _M0_MixedOneResult_Mock) Do(fn func(pkg.String, ...pkg.String) error) {
+	_m._recv._MixedOneResult_Do(fn)
+}
+
+func (_m _M0_MixedOneResult_Mock) DoT(t testing.TB, fn func(pkg.String, ...pkg.String) error) {
+	t.Helper()
+	_m._recv._MixedOneResult_DoT(t, fn)
+}
+
+func (_m _M0_MixedOneResult_Mock) Stub() {
+	_m._recv._MixedOneResult_Stub()
+}
+
+func (_m _M0_MixedOneResult_Mock) StubT(t testing.TB) {
+	t.Helper()
+	_m._recv._MixedOneResult_StubT(t)
+}
+
+func (_m _M0_MixedOneResult_Mock) Return(r0 error) {
+	_m._recv._MixedOneResult_Return(r0)
+}
+
+func (_m _M0_MixedOneResult_Mock) ReturnT(t testing.TB, r0 error) {
+	t.Helper()
+	_m._recv._MixedOneResult_ReturnT(t, r0)
+}
+
+func (_m _M0_MixedOneResult_Mock) Calls() []_M0_MixedOneResult_Call {
+	return _m._recv._MixedOneResult_Calls()
+}
+
+func (_m _M0_MixedOneResult_Mock) All(t testing.TB) _M0_MixedOneResult_AllMock {
+	return _M0_MixedOneResult_AllMock{t}
+}
+
+type _M0_MixedOneResult_AllMock struct{ t testing.TB }
+
+func (_m _M0_MixedOneResult_AllMock) Do(fn func(pkg.String, ...pkg.String) error) {
+	_m.t.Helper()
+	new(M0)._MixedOneResult_DoAll(_m.t, fn)
+}
+
+func (_m _M0_MixedOneResult_AllMock) Stub() {
+	_m.t.Helper()
+	new(M0)._MixedOneResult_StubAll(_m.t)
+}
+
+func (_m _M0_MixedOneResult_AllMock) Return(r0 error) {
+	_m.t.Helper()
+	new(M0)._MixedOneResult_ReturnAll(_m.t, r0)
+}
+
+func (_m _M0_MixedOneResult_AllMock) Calls() []_M0_MixedOneResult_Call {
+	return new(M0)._MixedOneResult_AllCalls()
+}
+
+func (_m _M0_MixedOneResult_AllMock) Bubble() {
+	_m.t.Helper()
+	new(M0)._MixedOneResult_BubbleCalls(_m.t)
+}
+
+func (_m _M0_MixedOneResult_AllMock) Record(limit int) {
+	_m.t.Helper()
+	new(M0)._MixedOneResult_Record(_m.t, limit)
+}
+
+type _M0_MixedTwoResults_Mock struct{ _recv *M0 }
+
+func (_m _M0_MixedTwoResults_Mock) Do(fn func(pkg.String, ...pkg.String) (pkg.Int, error)) {
+	_m._recv._MixedTwoResults_Do(fn)
+}
+
+func (_m _M0_MixedTwoResults_Mock) DoT(t testing.TB, fn func(pkg.String, ...pkg.String) (pkg.Int, error)) {
+	t.Helper()
+	_m._recv._MixedTwoResults_DoT(t, fn)
+}
+
+func (_m _M0_MixedTwoResults_Mock) Stub() {
+	_m._recv._MixedTwoResults_Stub()
+}
+
+func (_m _M0_MixedTwoResults_Mock) StubT(t testing.TB) {
+	t.Helper()
+	_m._recv._MixedTwoResults_StubT(t)
+}
+
+func (_m _M0_MixedTwoResults_Mock) Return(r0 pkg.Int, r1 error) {
+	_m._recv._MixedTwoResults_Return(r0, r1)
+}
+
+func (_m _M0_MixedTwoResults_Mock) ReturnT(t testing.TB, r0 pkg.Int, r1 error) {
+	t.Helper()
+	_m._recv._MixedTwoResults_ReturnT(t, r0, r1)
+}
+
+func (_m _M0_MixedTwoResults_Mock) Calls() []_M0_MixedTwoResults_Call {
+	return _m._recv._MixedTwoResults_Calls()
+}
+
+func (_m _M0_MixedTwoResults_Mock) All(t testing.TB) _M0_MixedTwoResults_AllMock {
+	return _M0_MixedTwoResults_AllMock{t}
+}
+
+type _M0_MixedTwoResults_AllMock struct{ t testing.TB }
+
+func (_m _M0_MixedTwoResults_AllMock) Do(fn func(pkg.String, ...pkg.String) (pkg.Int, error)) {
+	_m.t.Helper()
+	new(M0)._MixedTwoResults_DoAll(_m.t, fn)
+}
+
+func (_m _M0_MixedTwoResults_AllMock) Stub() {
+	_m.t.Helper()
+	new(M0)._MixedTwoResults_StubAll(_m.t)
+}
+
+func (_m _M0_MixedTwoResults_AllMock) Return(r0 pkg.Int, r1 error) {
+	_m.t.Helper()
+	new(M0)._MixedTwoResults_ReturnAll(_m.t, r0, r1)
+}
+
+func (_m _M0_MixedTwoResults_AllMock) Calls() []_M0_MixedTwoResults_Call {
+	return new(M0)._MixedTwoResults_AllCalls()
+}
+
+func (_m _M0_MixedTwoResults_AllMock) Bubble() {
+	_m.t.Helper()
+	new(M0)._MixedTwoResults_BubbleCalls(_m.t)
+}
+
+func (_m _M0_MixedTwoResults_AllMock) Record(limit int) {
+	_m.t.Helper()
+	new(M0)._MixedTwoResults_Record(_m.t, limit)
+}
+
+type _M0_NamedMixedNoResult_Mock struct{ _recv *M0 }
+
+func (_m _M0_NamedMixedNoResult_Mock) Do(fn func(pkg.String, ...pkg.String)) {
+	_m._recv._NamedMixedNoResult_Do(fn)
+}
+
+func (_m _M0_NamedMixedNoResult_Mock) DoT(t testing.TB, fn func(pkg.String, ...pkg.String)) {
+	t.Helper()
+	_m._recv._NamedMixedNoResult_DoT(t, fn)
+}
+
+func (_m _M0_NamedMixedNoResult_Mock) Stub() {
+	_m._recv._NamedMixedNoResult_Stub()
+}
+
+func (_m _M0_NamedMixedNoResult_Mock) StubT(t testing.TB) {
+	t.Helper()
+	_m._recv._NamedMixedNoResult_StubT(t)
+}
+
+func (_m _M0_NamedMixedNoResult_Mock) Return() {
+	_m._recv._NamedMixedNoResult_Return()
+}
+
+func (_m _M0_NamedMixedNoResult_Mock) ReturnT(t testing.TB) {
+	t.Helper()
+	_m._recv._NamedMixedNoResult_ReturnT(t)
+}
+
+func (_m _M0_NamedMixedNoResult_Mock) Calls() []_M0_NamedMixedNoResult_Call {
+	return _m._recv._NamedMixedNoResult_Calls()
+}
+
+func (_m _M0_NamedMixedNoResult_Mock) All(t testing.TB) _M0_NamedMixedNoResult_AllMock {
+	return _M0_NamedMixedNoResult_AllMock{t}
+}
+
+type _M0_NamedMixedNoResult_AllMock struct{ t testing.TB }
+
+func (_m _M0_NamedMixedNoResult_AllMock) Do(fn func(pkg.String, ...pkg.String)) {
+	_m.t.Helper()
+	new(M0)._NamedMixedNoResult_DoAll(_m.t, fn)
+}
+
+func (_m _M0_NamedMixedNoResult_AllMock) Stub() {
+	_m.t.Helper()
+	new(M0)._NamedMixedNoResult_StubAll(_m.t)
+}
+
+func (_m _M0_NamedMixedNoResult_AllMock) Return() {
+	_m.t.Helper()
+	new(M0)._NamedMixedNoResult_ReturnAll(_m.t)
+}
+
+func (_m _M0_NamedMixedNoResult_AllMock) Calls() []_M0_NamedMixedNoResult_Call {
+	return new(M0)._NamedMixedNoResult_AllCalls()
+}
+
+func (_m _M0_NamedMixedNoResult_AllMock) Bubble() {
+	_m.t.Helper()
+	new(M0)._NamedMixedNoResult_BubbleCalls(_m.t)
+}
+
+func (_m _M0_NamedMixedNoResult_AllMock) Record(limit int) {
+	_m.t.Helper()
+	new(M0)._NamedMixedNoResult_Record(_m.t, limit)
+}
+
+type _M0_NamedMixedOneResult_Mock struct{ _recv *M0 }
+
+func (_m _M0_NamedMixedOneResult_Mock) Do(fn func(pkg.String, ...pkg.String) error) {
+	_m._recv._NamedMixedOneResult_Do(fn)
+}
+
+func (_m _M0_NamedMixedOneResult_Mock) DoT(t testing.TB, fn func(pkg.String, ...pkg.String) error) {
+	t.Helper()
+	_m._recv._NamedMixedOneResult_DoT(t, fn)
+}
+
+func (_m _M0_NamedMixedOneResult_Mock) Stub() {
+	_m._recv._NamedMixedOneResult_Stub()
+}
+
+func (_m _M0_NamedMixedOneResult_Mock) StubT(t testing.TB) {
+	t.Helper()
+	_m._recv._NamedMixedOneResult_StubT(t)
+}
+
+func (_m _M0_NamedMixedOneResult_Mock) Return(r0 error) {
+	_m._recv._NamedMixedOneResult_Return(r0)
+}
+
+func (_m _M0_NamedMixedOneResult_Mock) ReturnT(t testing.TB, r0 error) {
+	t.Helper()
+	_m._recv._NamedMixedOneResult_ReturnT(t, r0)
+}
+
+func (_m _M0_NamedMixedOneResult_Mock) Calls() []_M0_NamedMixedOneResult_Call {
+	return _m._recv._NamedMixedOneResult_Calls()
+}
+
+func (_m _M0_NamedMixedOneResult_Mock) All(t testing.TB) _M0_NamedMixedOneResult_AllMock {
+	return _M0_NamedMixedOneResult_AllMock{t}
+}
+
+type _M0_NamedMixedOneResult_AllMock struct{ t testing.TB }
+
+func (_m _M0_NamedMixedOneResult_AllMock) Do(fn func(pkg.String, ...pkg.String) error) {
+	_m.t.Helper()
+	new(M0)._NamedMixedOneResult_DoAll(_m.t, fn)
+}
+
+func (_m _M0_NamedMixedOneResult_AllMock) Stub() {
+	_m.t.Helper()
+	new(M0)._NamedMixedOneResult_StubAll(_m.t)
+}
+
+func (_m _M0_NamedMixedOneResult_AllMock) Return(r0 error) {
+	_m.t.Helper()
+	new(M0)._NamedMixedOneResult_ReturnAll(_m.t, r0)
+}
+
+func (_m _M0_NamedMixedOneResult_AllMock) Calls() []_M0_NamedMixedOneResult_Call {
+	return new(M0)._NamedMixedOneResult_AllCalls()
+}
+
+func (_m _M0_NamedMixedOneResult_AllMock) Bubble() {
+	_m.t.Helper()
+	new(M0)._NamedMixedOneResult_BubbleCalls(_m.t)
+}
+
+func (_m _M0_NamedMixedOneResult_AllMock) Record(limit int) {
+	_m.t.Helper()
+	new(M0)._NamedMixedOneResult_Record(_m.t, limit)
+}
+
+type _M0_NamedMixedTwoResults_Mock struct{ _recv *M0 }
+
+func (_m _M0_NamedMixedTwoResults_Mock) Do(fn func(pkg.String, ...pkg.String) (pkg.Int, error)) {
+	_m._recv._NamedMixedTwoResults_Do(fn)
+}
+
+func (_m _M0_NamedMixedTwoResults_Mock) DoT(t testing.TB, fn func(pkg.String, ...pkg.String) (pkg.Int, error)) {
+	t.Helper()
+	_m._recv._NamedMixedTwoResults_DoT(t, fn)
+}
+
+func (_m _M0_NamedMixedTwoResults_Mock) Stub() {
+	_m._recv._NamedMixedTwoResults_Stub()
+}
+
+func (_m _M0_NamedMixedTwoResults_Mock) StubT(t testing.TB) {
+	t.Helper()
+	_m._recv._NamedMixedTwoResults_StubT(t)
+}
+
+func (_m _M0_NamedMixedTwoResults_Mock) Return(r0 pkg.Int, r1 error) {
+	_m._recv._NamedMixedTwoResults_Return(r0, r1)
+}
+
+func (_m _M0_NamedMixedTwoResults_Mock) ReturnT(t testing.TB, r0 pkg.Int, r1 error) {
+	t.Helper()
+	_m._recv._NamedMixedTwoResults_ReturnT(t, r0, r1)
+}
+
+func (_m _M0_NamedMixedTwoResults_Mock) Calls() []_M0_NamedMixedTwoResults_Call {
+	return _m._recv._NamedMixedTwoResults_Calls()
+}
+
+func (_m _M0_NamedMixedTwoResults_Mock) All(t testing.TB) _M0_NamedMixedTwoResults_AllMock {
+	return _M0_NamedMixedTwoResults_AllMock{t}
+}
+
+type _M0_NamedMixedTwoResults_AllMock struct{ t testing.TB }
+
+func (_m _M0_NamedMixedTwoResults_AllMock) Do(fn func(pkg.String, ...pkg.String) (pkg.Int, error)) {
+	_m.t.Helper()
+	new(M0)._NamedMixedTwoResults_DoAll(_m.t, fn)
+}
+
+func (_m _M0_NamedMixedTwoResults_AllMock) Stub() {
+	_m.t.Helper()
+	new(M0)._NamedMixedTwoResults_StubAll(_m.t)
+}
+
+func (_m _M0_NamedMixedTwoResults_AllMock) Return(r0 pkg.Int, r1 error) {
+	_m.t.Helper()
+	new(M0)._NamedMixedTwoResults_ReturnAll(_m.t, r0, r1)
+}
+
+func (_m _M0_NamedMixedTwoResults_AllMock) Calls() []_M0_NamedMixedTwoResults_Call {
+	return new(M0)._NamedMixedTwoResults_AllCalls()
+}
+
+func (_m _M0_NamedMixedTwoResults_AllMock) Bubble() {
+	_m.t.Helper()
+	new(M0)._NamedMixedTwoResults_BubbleCalls(_m.t)
+}
+
+func (_m _M0_NamedMixedTwoResults_AllMock) Record(limit int) {
+	_m.t.Helper()
+	new(M0)._NamedMixedTwoResults_Record(_m.t, limit)
+}
+
+type _M0_NamedParamNoResult_Mock struct{ _recv *M0 }
+
+func (_m _M0_NamedParamNoResult_Mock) Do(fn func(pkg.String)) {
+	_m._recv._NamedParamNoResult_Do(fn)
+}
+
+func (_m _M0_NamedParamNoResult_Mock) DoT(t testing.TB, fn func(pkg.String)) {
+	t.Helper()
+	_m._recv._NamedParamNoResult_DoT(t, fn)
+}
+
+func (_m _M0_NamedParamNoResult_Mock) Stub() {
+	_m._recv._NamedParamNoResult_Stub()
+}
+
+func (_m _M0_NamedParamNoResult_Mock) StubT(t testing.TB) {
+	t.Helper()
+	_m._recv._NamedParamNoResult_StubT(t)
+}
+
+func (_m _M0_NamedParamNoResult_Mock) Return() {
+	_m._recv._NamedParamNoResult_Return()
+}
+
+func (_m _M0_NamedParamNoResult_Mock) ReturnT(t testing.TB) {
+	t.Helper()
+	_m._recv._NamedParamNoResult_ReturnT(t)
+}
+
+func (_m _M0_NamedParamNoResult_Mock) Calls() []_M0_NamedParamNoResult_Call {
+	return _m._recv._NamedParamNoResult_Calls()
+}
+
+func (_m _M0_NamedParamNoResult_Mock) All(t testing.TB) _M0_NamedParamNoResult_AllMock {
+	return _M0_NamedParamNoResult_AllMock{t}
+}
+
+type _M0_NamedParamNoResult_AllMock struct{ t testing.TB }
+
+func (_m _M0_NamedParamNoResult_AllMock) Do(fn func(pkg.String)) {
+	_m.t.Helper()
+	new(M0)._NamedParamNoResult_DoAll(_m.t, fn)
+}
+
+func (_m _M0_NamedParamNoResult_AllMock) Stub() {
+	_m.t.Helper()
+	new(M0)._NamedParamNoResult_StubAll(_m.t)
+}
+
+func (_m _M0_NamedParamNoResult_AllMock) Return() {
+	_m.t.Helper()
+	new(M0)._NamedParamNoResult_ReturnAll(_m.t)
+}
+
+func (_m _M0_NamedParamNoResult_AllMock) Calls() []_M0_NamedParamNoResult_Call {
+	return new(M0)._NamedParamNoResult_AllCalls()
+}
+
+func (_m _M0_NamedParamNoResult_AllMock) Bubble() {
+	_m.t.Helper()
+	new(M0)._NamedParamNoResult_BubbleCalls(_m.t)
+}
+
+func (_m _M0_NamedParamNoResult_AllMock) Record(limit int) {
+	_m.t.Helper()
+	new(M0)._NamedParamNoResult_Record(_m.t, limit)
+}
+
+type _M0_NamedParamOneResult_Mock struct{ _recv *M0 }
+
+func (_m _M0_NamedParamOneResult_Mock) Do(fn func(pkg.String) error) {
+	_m._recv._NamedParamOneResult_Do(fn)
+}
+
+func (_m _M0_NamedParamOneResult_Mock) DoT(t testing.TB, fn func(pkg.String) error) {
+	t.Helper()
+	_m._recv._NamedParamOneResult_DoT(t, fn)
+}
+
+func (_m _M0_NamedParamOneResult_Mock) Stub() {
+	_m._recv._NamedParamOneResult_Stub()
+}
+
+func (_m _M0_NamedParamOneResult_Mock) StubT(t testing.TB) {
+	t.Helper()
+	_m._recv._NamedParamOneResult_StubT(t)
+}
+
+func (_m _M0_NamedParamOneResult_Mock) Return(r0 error) {
+	_m._recv._NamedParamOneResult_Return(r0)
+}
+
+func (_m _M0_NamedParamOneResult_Mock) ReturnT(t testing.TB, r0 error) {
+	t.Helper()
+	_m._recv._NamedParamOneResult_ReturnT(t, r0)
+}
+
+func (_m _M0_NamedParamOneResult_Mock) Calls() []_M0_NamedParamOneResult_Call {
+	return _m._recv._NamedParamOneResult_Calls()
+}
+
+func (_m _M0_NamedParamOneResult_Mock) All(t testing.TB) _M0_NamedParamOneResult_AllMock {
+	return _M0_NamedParamOneResult_AllMock{t}
+}
+
+type _M0_NamedParamOneResult_AllMock struct{ t testing.TB }
+
+func (_m _M0_NamedParamOneResult_AllMock) Do(fn func(pkg.String) error) {
+	_m.t.Helper()
+	new(M0)._NamedParamOneResult_DoAll(_m.t, fn)
+}
+
+func (_m _M0_NamedParamOneResult_AllMock) Stub() {
+	_m.t.Helper()
+	new(M0)._NamedParamOneResult_StubAll(_m.t)
+}
+
+func (_m _M0_NamedParamOneResult_AllMock) Return(r0 error) {
+	_m.t.Helper()
+	new(M0)._NamedParamOneResult_ReturnAll(_m.t, r0)
+}
+
+func (_m _M0_NamedParamOneResult_AllMock) Calls() []_M0_NamedParamOneResult_Call {
+	return new(M0)._NamedParamOneResult_AllCalls()
+}
+
+func (_m _M0_NamedParamOneResult_AllMock) Bubble() {
+	_m.t.Helper()
+	new(M0)._NamedParamOneResult_BubbleCalls(_m.t)
+}
+
+func (_m _M0_NamedParamOneResult_AllMock) Record(limit int) {
+	_m.t.Helper()
+	new(M0)._NamedParamOneResult_Record(_m.t, limit)
+}
+
+type _M0_NamedParamTwoResults_Mock struct{ _recv *M0 }
+
+func (_m _M0_NamedParamTwoResults_Mock) Do(fn func(pkg.String) (pkg.Int, error)) {
+	_m._recv._NamedParamTwoResults_Do(fn)
+}
+
+func (_m _M0_NamedParamTwoResults_Mock) DoT(t testing.TB, fn func(pkg.String) (pkg.Int, error)) {
+	t.Helper()
+	_m._recv._NamedParamTwoResults_DoT(t, fn)
+}
+
+func (_m _M0_NamedParamTwoResults_Mock) Stub() {
+	_m._recv._NamedParamTwoResults_Stub()
+}
+
+func (_m _M0_NamedParamTwoResults_Mock) StubT(t testing.TB) {
+	t.Helper()
+	_m._recv._NamedParamTwoResults_StubT(t)
+}
+
+func (_m _M0_NamedParamTwoResults_Mock) Return(r0 pkg.Int, r1 error) {
+	_m._recv._NamedParamTwoResults_Return(r0, r1)
+}
+
+func (_m _M0_NamedParamTwoResults_Mock) ReturnT(t testing.TB, r0 pkg.Int, r1 error) {
+	t.Helper()
+	_m._recv._NamedParamTwoResults_ReturnT(t, r0, r1)
+}
+
+func (_m _M0_NamedParamTwoResults_Mock) Calls() []_M0_NamedParamTwoResults_Call {
+	return _m._recv._NamedParamTwoResults_Calls()
+}
+
+func (_m _M0_NamedParamTwoResults_Mock) All(t testing.TB) _M0_NamedParamTwoResults_AllMock {
+	return _M0_NamedParamTwoResults_AllMock{t}
+}
+
+type _M0_NamedParamTwoResults_AllMock struct{ t testing.TB }
+
+func (_m _M0_NamedParamTwoResults_AllMock) Do(fn func(pkg.String) (pkg.Int, error)) {
+	_m.t.Helper()
+	new(M0)._NamedParamTwoResults_DoAll(_m.t, fn)
+}
+
+func (_m _M0_NamedParamTwoResults_AllMock) Stub() {
+	_m.t.Helper()
+	new(M0)._NamedParamTwoResults_StubAll(_m.t)
+}
+
+func (_m _M0_NamedParamTwoResults_AllMock) Return(r0 pkg.Int, r1 error) {
+	_m.t.Helper()
+	new(M0)._NamedParamTwoResults_ReturnAll(_m.t, r0, r1)
+}
+
+func (_m _M0_NamedParamTwoResults_AllMock) Calls() []_M0_NamedParamTwoResults_Call {
+	return new(M0)._NamedParamTwoResults_AllCalls()
+}
+
+func (_m _M0_NamedParamTwoResults_AllMock) Bubble() {
+	_m.t.Helper()
+	new(M0)._NamedParamTwoResults_BubbleCalls(_m.t)
+}
+
+func (_m _M0_NamedParamTwoResults_AllMock) Record(limit int) {
+	_m.t.Helper()
+	new(M0)._NamedParamTwoResults_Record(_m.t, limit)
+}
+
+type _M0_OneNamedResult_Mock struct{ _recv *M0 }
+
+func (_m _M0_OneNamedResult_Mock) Do(fn func() error) {
+	_m._recv._OneNamedResult_Do(fn)
+}
+
+func (_m _M0_OneNamedResult_Mock) DoT(t testing.TB, fn func() error) {
+	t.Helper()
+	_m._recv._OneNamedResult_DoT(t, fn)
+}
+
+func (_m _M0_OneNamedResult_Mock) Stub() {
+	_m._recv._OneNamedResult_Stub()
+}
+
+func (_m _M0_OneNamedResult_Mock) StubT(t testing.TB) {
+	t.Helper()
+	_m._recv._OneNamedResult_StubT(t)
+}
+
+func (_m _M0_OneNamedResult_Mock) Return(err error) {
+	_m._recv._OneNamedResult_Return(err)
+}
+
+func (_m _M0_OneNamedResult_Mock) ReturnT(t testing.TB, err error) {
+	t.Helper()
+	_m._recv._OneNamedResult_ReturnT(t, err)
+}
+
+func (_m _M0_OneNamedResult_Mock) Calls() []_M0_OneNamedResult_Call {
+	return _m._recv._OneNamedResult_Calls()
+}
+
+func (_m _M0_OneNamedResult_Mock) All(t testing.TB) _M0_OneNamedResult_AllMock {
+	return _M0_OneNamedResult_AllMock{t}
+}
+
+type _M0_OneNamedResult_AllMock struct{ t testing.TB }
+
+func (_m _M0_OneNamedResult_AllMock) Do(fn func() error) {
+	_m.t.Helper()
+	new(M0)._OneNamedResult_DoAll(_m.t, fn)
+}
+
+func (_m _M0_OneNamedResult_AllMock) Stub() {
+	_m.t.Helper()
+	new(M0)._OneNamedResult_StubAll(_m.t)
+}
+
+func (_m _M0_OneNamedResult_AllMock) Return(err error) {
+	_m.t.Helper()
+	new(M0)._OneNamedResult_ReturnAll(_m.t, err)
+}
+
+func (_m _M0_OneNamedResult_AllMock) Calls() []_M0_OneNamedResult_Call {
+	return new(M0)._OneNamedResult_AllCalls()
+}
+
+func (_m _M0_OneNamedResult_AllMock) Bubble() {
+	_m.t.Helper()
+	new(M0)._OneNamedResult_BubbleCalls(_m.t)
+}
+
+func (_m _M0_OneNamedResult_AllMock) Record(limit int) {
+	_m.t.Helper()
+	new(M0)._OneNamedResult_Record(_m.t, limit)
+}
+
+type _M0_OneParamNoResult_Mock struct{ _recv *M0 }
+
+func (_m _M0_OneParamNoResult_Mock) Do(fn func(pkg.String)) {
+	_m._recv._OneParamNoResult_Do(fn)
+}
+
+func (_m _M0_OneParamNoResult_Mock) DoT(t testing.TB, fn func(pkg.String)) {
+	t.Helper()
+	_m._recv._OneParamNoResult_DoT(t, fn)
+}
+
+func (_m _M0_OneParamNoResult_Mock) Stub() {
+	_m._recv._OneParamNoResult_Stub()
+}
+
+func (_m _M0_OneParamNoResult_Mock) StubT(t testing.TB) {
+	t.Helper()
+	_m._recv._OneParamNoResult_StubT(t)
+}
+
+func (_m _M0_OneParamNoResult_Mock) Return() {
+	_m._recv._OneParamNoResult_Return()
+}
+
+func (_m _M0_OneParamNoResult_Mock) ReturnT(t testing.TB) {
+	t.Helper()
+	_m._recv._OneParamNoResult_ReturnT(t)
+}
+
+func (_m _M0_OneParamNoResult_Mock) Calls() []_M0_OneParamNoResult_Call {
+	return _m._recv._OneParamNoResult_Calls()
+}
+
+func (_m _M0_OneParamNoResult_Mock) All(t testing.TB) _M0_OneParamNoResult_AllMock {
+	return _M0_OneParamNoResult_AllMock{t}
+}
+
+type _M0_OneParamNoResult_AllMock struct{ t testing.TB }
+
+func (_m _M0_OneParamNoResult_AllMock) Do(fn func(pkg.String)) {
+	_m.t.Helper()
+	new(M0)._OneParamNoResult_DoAll(_m.t, fn)
+}
+
+func (_m _M0_OneParamNoResult_AllMock) Stub() {
+	_m.t.Helper()
+	new(M0)._OneParamNoResult_StubAll(_m.t)
+}
+
+func (_m _M0_OneParamNoResult_AllMock) Return() {
+	_m.t.Helper()
+	new(M0)._OneParamNoResult_ReturnAll(_m.t)
+}
+
+func (_m _M0_OneParamNoResult_AllMock) Calls() []_M0_OneParamNoResult_Call {
+	return new(M0)._OneParamNoResult_AllCalls()
+}
+
+func (_m _M0_OneParamNoResult_AllMock) Bubble() {
+	_m.t.Helper()
+	new(M0)._OneParamNoResult_BubbleCalls(_m.t)
+}
+
+func (_m _M0_OneParamNoResult_AllMock) Record(limit int) {
+	_m.t.Helper()
+	new(M0)._OneParamNoResult_Record(_m.t, limit)
+}
+
+type _M0_OneParamOneResult_Mock struct{ _recv *M0 }
+
+func (_m _M0_OneParamOneResult_Mock) Do(fn func(pkg.String) error) {
+	_m._recv._OneParamOneResult_Do(fn)
+}
+
+func (_m _M0_OneParamOneResult_Mock) DoT(t testing.TB, fn func(pkg.String) error) {
+	t.Helper()
+	_m._recv._OneParamOneResult_DoT(t, fn)
+}
+
+func (_m _M0_OneParamOneResult_Mock) Stub() {
+	_m._recv._OneParamOneResult_Stub()
+}
+
+func (_m _M0_OneParamOneResult_Mock) StubT(t testing.TB) {
+	t.Helper()
+	_m._recv._OneParamOneResult_StubT(t)
+}
+
+func (_m _M0_OneParamOneResult_Mock) Return(r0 error) {
+	_m._recv._OneParamOneResult_Return(r0)
+}
+
+func (_m _M0_OneParamOneResult_Mock) ReturnT(t testing.TB, r0 error) {
+	t.Helper()
+	_m._recv._OneParamOneResult_ReturnT(t, r0)
+}
+
+func (_m _M0_OneParamOneResult_Mock) Calls() []_M0_OneParamOneResult_Call {
+	return _m._recv._OneParamOneResult_Calls()
+}
+
+func (_m _M0_OneParamOneResult_Mock) All(t testing.TB) _M0_OneParamOneResult_AllMock {
+	return _M0_OneParamOneResult_AllMock{t}
+}
+
+type _M0_OneParamOneResult_AllMock struct{ t testing.TB }
+
+func (_m _M0_OneParamOneResult_AllMock) Do(fn func(pkg.String) error) {
+	_m.t.Helper()
+	new(M0)._OneParamOneResult_DoAll(_m.t, fn)
+}
+
+func (_m _M0_OneParamOneResult_AllMock) Stub() {
+	_m.t.Helper()
+	new(M0)._OneParamOneResult_StubAll(_m.t)
+}
+
+func (_m _M0_OneParamOneResult_AllMock) Return(r0 error) {
+	_m.t.Helper()
+	new(M0)._OneParamOneResult_ReturnAll(_m.t, r0)
+}
+
+func (_m _M0_OneParamOneResult_AllMock) Calls() []_M0_OneParamOneResult_Call {
+	return new(M0)._OneParamOneResult_AllCalls()
+}
+
+func (_m _M0_OneParamOneResult_AllMock) Bubble() {
+	_m.t.Helper()
+	new(M0)._OneParamOneResult_BubbleCalls(_m.t)
+}
+
+func (_m _M0_OneParamOneResult_AllMock) Record(limit int) {
+	_m.t.Helper()
+	new(M0)._OneParamOneResult_Record(_m.t, limit)
+}
+
+type _M0_OneParamTwoResults_Mock struct{ _recv *M0 }
+
+func (_m _M0_OneParamTwoResults_Mock) Do(fn func(pkg.String) (pkg.Int, error)) {
+	_m._recv._OneParamTwoResults_Do(fn)
+}
+
+func (_m _M0_OneParamTwoResults_Mock) DoT(t testing.TB, fn func(pkg.String) (pkg.Int, error)) {
+	t.Helper()
+	_m._recv._OneParamTwoResults_DoT(t, fn)
+}
+
+func (_m _M0_OneParamTwoResults_Mock) Stub() {
+	_m._recv._OneParamTwoResults_Stub()
+}
+
+func (_m _M0_OneParamTwoResults_Mock) StubT(t testing.TB) {
+	t.Helper()
+	_m._recv._OneParamTwoResults_StubT(t)
+}
+
+func (_m _M0_OneParamTwoResults_Mock) Return(r0 pkg.Int, r1 error) {
+	_m._recv._OneParamTwoResults_Return(r0, r1)
+}
+
+func (_m _M0_OneParamTwoResults_Mock) ReturnT(t testing.TB, r0 pkg.Int, r1 error) {
+	t.Helper()
+	_m._recv._OneParamTwoResults_ReturnT(t, r0, r1)
+}
+
+func (_m _M0_OneParamTwoResults_Mock) Calls() []_M0_OneParamTwoResults_Call {
+	return _m._recv._OneParamTwoResults_Calls()
+}
+
+func (_m _M0_OneParamTwoResults_Mock) All(t testing.TB) _M0_OneParamTwoResults_AllMock {
+	return _M0_OneParamTwoResults_AllMock{t}
+}
+
+type _M0_OneParamTwoResults_AllMock struct{ t testing.TB }
+
+func (_m _M0_OneParamTwoResults_AllMock) Do(fn func(pkg.String) (pkg.Int, error)) {
+	_m.t.Helper()
+	new(M0)._OneParamTwoResults_DoAll(_m.t, fn)
+}
+
+func (_m _M0_OneParamTwoResults_AllMock) Stub() {
+	_m.t.Helper()
+	new(M0)._OneParamTwoResults_StubAll(_m.t)
+}
+
+func (_m _M0_OneParamTwoResults_AllMock) Return(r0 pkg.Int, r1 error) {
+	_m.t.Helper()
+	new(M0)._OneParamTwoResults_ReturnAll(_m.t, r0, r1)
+}
+
+func (_m _M0_OneParamTwoResults_AllMock) Calls() []_M0_OneParamTwoResults_Call {
+	return new(M0)._OneParamTwoResults_AllCalls()
+}
+
+func (_m _M0_OneParamTwoResults_AllMock) Bubble() {
+	_m.t.Helper()
+	new(M0)._OneParamTwoResults_BubbleCalls(_m.t)
+}
+
+func (_m _M0_OneParamTwoResults_AllMock) Record(limit int) {
+	_m.t.Helper()
+	new(M0)._OneParamTwoResults_Record(_m.t, limit)
+}
+
+type _M0_OneResult_Mock struct{ _recv *M0 }
+
+func (_m _M0_OneResult_Mock) Do(fn func() error) {
+	_m._recv._OneResult_Do(fn)
+}
+
+func (_m _M0_OneResult_Mock) DoT(t testing.TB, fn func() error) {
+	t.Helper()
+	_m._recv._OneResult_DoT(t, fn)
+}
+
+func (_m _M0_OneResult_Mock) Stub() {
+	_m._recv._OneResult_Stub()
+}
+
+func (_m _M0_OneResult_Mock) StubT(t testing.TB) {
+	t.Helper()
+	_m._recv._OneResult_StubT(t)
+}
+
+func (_m _M0_OneResult_Mock) Return(r0 error) {
+	_m._recv._OneResult_Return(r0)
+}
+
+func (_m _M0_OneResult_Mock) ReturnT(t testing.TB, r0 error) {
+	t.Helper()
+	_m._recv._OneResult_ReturnT(t, r0)
+}
+
+func (_m _M0_OneResult_Mock) Calls() []_M0_OneResult_Call {
+	return _m._recv._OneResult_Calls()
+}
+
+func (_m _M0_OneResult_Mock) All(t testing.TB) _M0_OneResult_AllMock {
+	return _M0_OneResult_AllMock{t}
+}
+
+type _M0_OneResult_AllMock struct{ t testing.TB }
+
+func (_m _M0_OneResult_AllMock) Do(fn func() error) {
+	_m.t.Helper()
+	new(M0)._OneResult_DoAll(_m.t, fn)
+}
+
+func (_m _M0_OneResult_AllMock) Stub() {
+	_m.t.Helper()
+	new(M0)._OneResult_StubAll(_m.t)
+}
+
+func (_m _M0_OneResult_AllMock) Return(r0 error) {
+	_m.t.Helper()
+	new(M0)._OneResult_ReturnAll(_m.t, r0)
+}
+
+func (_m _M0_OneResult_AllMock) Calls() []_M0_OneResult_Call {
+	return new(M0)._OneResult_AllCalls()
+}
+
+func (_m _M0_OneResult_AllMock) Bubble() {
+	_m.t.Helper()
+	new(M0)._OneResult_BubbleCalls(_m.t)
+}
+
+func (_m _M0_OneResult_AllMock) Record(limit int) {
+	_m.t.Helper()
+	new(M0)._OneResult_Record(_m.t, limit)
+}
+
+type _M0_Read_Mock struct{ _recv *M0 }
+
+func (_m _M0_Read_Mock) Do(fn func([]byte) (int, error)) {
+	_m._recv._Read_Do(fn)
+}
+
+func (_m _M0_Read_Mock) DoT(t testing.TB, fn func([]byte) (int, error)) {
+	t.Helper()
+	_m._recv._Read_DoT(t, fn)
+}
+
+func (_m _M0_Read_Mock) Stub() {
+	_m._recv._Read_Stub()
+}
+
+func (_m _M0_Read_Mock) StubT(t testing.TB) {
+	t.Helper()
+	_m._recv._Read_StubT(t)
+}
+
+func (_m _M0_Read_Mock) Return(n int, err error) {
+	_m._recv._Read_Return(n, err)
+}
+
+func (_m _M0_Read_Mock) ReturnT(t testing.TB, n int, err error) {
+	t.Helper()
+	_m._recv._Read_ReturnT(t, n, err)
+}
+
+func (_m _M0_Read_Mock) Calls() []_M0_Read_Call {
+	return _m._recv._Read_Calls()
+}
+
+func (_m _M0_Read_Mock) All(t testing.TB) _M0_Read_AllMock {
+	return _M0_Read_AllMock{t}
+}
+
+type _M0_Read_AllMock struct{ t testing.TB }
+
+func (_m _M0_Read_AllMock) Do(fn func([]byte) (int, error)) {
+	_m.t.Helper()
+	new(M0)._Read_DoAll(_m.t, fn)
+}
+
+func (_m _M0_Read_AllMock) Stub() {
+	_m.t.Helper()
+	new(M0)._Read_StubAll(_m.t)
+}
+
+func (_m _M0_Read_AllMock) Return(n int, err error) {
+	_m.t.Helper()
+	new(M0)._Read_ReturnAll(_m.t, n, err)
+}
+
+func (_m _M0_Read_AllMock) Calls() []_M0_Read_Call {
+	return new(M0)._Read_AllCalls()
+}
+
+func (_m _M0_Read_AllMock) Bubble() {
+	_m.t.Helper()
+	new(M0)._Read_BubbleCalls(_m.t)
+}
+
+func (_m _M0_Read_AllMock) Record(limit int) {
+	_m.t.Helper()
+	new(M0)._Read_Record(_m.t, limit)
+}
+
+type _M0_Simple_Mock struct{ _recv *M0 }
+
+func (_m _M0_Simple_Mock) Do(fn func()) {
+	_m._recv._Simple_Do(fn)
+}
+
+func (_m _M0_Simple_Mock) DoT(t testing.TB, fn func()) {
+	t.Helper()
+	_m._recv._Simple_DoT(t, fn)
+}
+
+func (_m _M0_Simple_Mock) Stub() {
+	_m._recv._Simple_Stub()
+}
+
+func (_m _M0_Simple_Mock) StubT(t testing.TB) {
+	t.Helper()
+	_m._recv._Simple_StubT(t)
+}
+
+func (_m _M0_Simple_Mock) Return() {
+	_m._recv._Simple_Return()
+}
+
+func (_m _M0_Simple_Mock) ReturnT(t testing.TB) {
+	t.Helper()
+	_m._recv._Simple_ReturnT(t)
+}
+
+func (_m _M0_Simple_Mock) Calls() []_M0_Simple_Call {
+	return _m._recv._Simple_Calls()
+}
+
+func (_m _M0_Simple_Mock) All(t testing.TB) _M0_Simple_AllMock {
+	return _M0_Simple_AllMock{t}
+}
+
+type _M0_Simple_AllMock struct{ t testing.TB }
+
+func (_m _M0_Simple_AllMock) Do(fn func()) {
+	_m.t.Helper()
+	new(M0)._Simple_DoAll(_m.t, fn)
+}
+
+func (_m _M0_Simple_AllMock) Stub() {
+	_m.t.Helper()
+	new(M0)._Simple_StubAll(_m.t)
+}
+
+func (_m _M0_Simple_AllMock) Return() {
+	_m.t.Helper()
+	new(M0)._Simple_ReturnAll(_m.t)
+}
+
+func (_m _M0_Simple_AllMock) Calls() []_M0_Simple_Call {
+	return new(M0)._Simple_AllCalls()
+}
+
+func (_m _M0_Simple_AllMock) Bubble() {
+	_m.t.Helper()
+	new(M0)._Simple_BubbleCalls(_m.t)
+}
+
+func (_m _M0_Simple_AllMock) Record(limit int) {
+	_m.t.Helper()
+	new(M0)._Simple_Record(_m.t, limit)
+}
+
+type _M0_TwoNamedResults_Mock struct{ _recv *M0 }
+
+func (_m _M0_TwoNamedResults_Mock) Do(fn func() (pkg.Int, error)) {
+	_m._recv._TwoNamedResults_Do(fn)
+}
+
+func (_m _M0_TwoNamedResults_Mock) DoT(t testing.TB, fn func() (pkg.Int, error)) {
+	t.Helper()
+	_m._recv._TwoNamedResults_DoT(t, fn)
+}
+
+func (_m _M0_TwoNamedResults_Mock) Stub() {
+	_m._recv._TwoNamedResults_Stub()
+}
+
+func (_m _M0_TwoNamedResults_Mock) StubT(t testing.TB) {
+	t.Helper()
+	_m._recv._TwoNamedResults_StubT(t)
+}
+
+func (_m _M0_TwoNamedResults_Mock) Return(n pkg.Int, err error) {
+	_m._recv._TwoNamedResults_Return(n, err)
+}
+
+func (_m _M0_TwoNamedResults_Mock) ReturnT(t testing.TB, n pkg.Int, err error) {
+	t.Helper()
+	_m._recv._TwoNamedResults_ReturnT(t, n, err)
+}
+
+func (_m _M0_TwoNamedResults_Mock) Calls() []_M0_TwoNamedResults_Call {
+	return _m._recv._TwoNamedResults_Calls()
+}
+
+func (_m _M0_TwoNamedResults_Mock) All(t testing.TB) _M0_TwoNamedResults_AllMock {
+	return _M0_TwoNamedResults_AllMock{t}
+}
+
+type _M0_TwoNamedResults_AllMock struct{ t testing.TB }
+
+func (_m _M0_TwoNamedResults_AllMock) Do(fn func() (pkg.Int, error)) {
+	_m.t.Helper()
+	new(M0)._TwoNamedResults_DoAll(_m.t, fn)
+}
+
+func (_m _M0_TwoNamedResults_AllMock) Stub() {
+	_m.t.Helper()
+	new(M0)._TwoNamedResults_StubAll(_m.t)
+}
+
+func (_m _M0_TwoNamedResults_AllMock) Return(n pkg.Int, err error) {
+	_m.t.Helper()
+	new(M0)._TwoNamedResults_ReturnAll(_m.t, n, err)
+}
+
+func (_m _M0_TwoNamedResults_AllMock) Calls() []_M0_TwoNamedResults_Call {
+	return new(M0)._TwoNamedResults_AllCalls()
+}
+
+func (_m _M0_TwoNamedResults_AllMock) Bubble() {
+	_m.t.Helper()
+	new(M0)._TwoNamedResults_BubbleCalls(_m.t)
+}
+
+func (_m _M0_TwoNamedResults_AllMock) Record(limit int) {
+	_m.t.Helper()
+	new(M0)._TwoNamedResults_Record(_m.t, limit)
+}
+
+type _M0_TwoParamsNoResult_Mock struct{ _recv *M0 }
+
+func (_m _M0_TwoParamsNoResult_Mock) Do(fn func(pkg.String, pkg.String)) {
+	_m._recv._TwoParamsNoResult_Do(fn)
+}
+
+func (_m _M0_TwoParamsNoResult_Mock) DoT(t testing.TB, fn func(pkg.String, pkg.String)) {
+	t.Helper()
+	_m._recv._TwoParamsNoResult_DoT(t, fn)
+}
+
+func (_m _M0_TwoParamsNoResult_Mock) Stub() {
+	_m._recv._TwoParamsNoResult_Stub()
+}
+
+func (_m _M0_TwoParamsNoResult_Mock) StubT(t testing.TB) {
+	t.Helper()
+	_m._recv._TwoParamsNoResult_StubT(t)
+}
+
+func (_m _M0_TwoParamsNoResult_Mock) Return() {
+	_m._recv._TwoParamsNoResult_Return()
+}
+
+func (_m _M0_TwoParamsNoResult_Mock) ReturnT(t testing.TB) {
+	t.Helper()
+	_m._recv._TwoParamsNoResult_ReturnT(t)
+}
+
+func (_m _M0_TwoParamsNoResult_Mock) Calls() []_M0_TwoParamsNoResult_Call {
+	return _m._recv._TwoParamsNoResult_Calls()
+}
+
+func (_m _M0_TwoParamsNoResult_Mock) All(t testing.TB) _M0_TwoParamsNoResult_AllMock {
+	return _M0_TwoParamsNoResult_AllMock{t}
+}
+
+type _M0_TwoParamsNoResult_AllMock struct{ t testing.TB }
+
+func (_m _M0_TwoParamsNoResult_AllMock) Do(fn func(pkg.String, pkg.String)) {
+	_m.t.Helper()
+	new(M0)._TwoParamsNoResult_DoAll(_m.t, fn)
+}
+
+func (_m _M0_TwoParamsNoResult_AllMock) Stub() {
+	_m.t.Helper()
+	new(M0)._TwoParamsNoResult_StubAll(_m.t)
+}
+
+func (_m _M0_TwoParamsNoResult_AllMock) Return() {
+	_m.t.Helper()
+	new(M0)._TwoParamsNoResult_ReturnAll(_m.t)
+}
+
+func (_m _M0_TwoParamsNoResult_AllMock) Calls() []_M0_TwoParamsNoResult_Call {
+	return new(M0)._TwoParamsNoResult_AllCalls()
+}
+
+func (_m _M0_TwoParamsNoResult_AllMock) Bubble() {
+	_m.t.Helper()
+	new(M0)._TwoParamsNoResult_BubbleCalls(_m.t)
+}
+
+func (_m _M0_TwoParamsNoResult_AllMock) Record(limit int) {
+	_m.t.Helper()
+	new(M0)._TwoParamsNoResult_Record(_m.t, limit)
+}
+
+type _M0_TwoParamsOneResult_Mock struct{ _recv *M0 }
+
+func (_m _M0_TwoParamsOneResult_Mock) Do(fn func(pkg.String, pkg.String) error) {
+	_m._recv._TwoParamsOneResult_Do(fn)
+}
+
+func (_m _M0_TwoParamsOneResult_Mock) DoT(t testing.TB, fn func(pkg.String, pkg.String) error) {
+	t.Helper()
+	_m._recv._TwoParamsOneResult_DoT(t, fn)
+}
+
+func (_m _M0_TwoParamsOneResult_Mock) Stub() {
+	_m._recv._TwoParamsOneResult_Stub()
+}
+
+func (_m _M0_TwoParamsOneResult_Mock) StubT(t testing.TB) {
+	t.Helper()
+	_m._recv._TwoParamsOneResult_StubT(t)
+}
+
+func (_m _M0_TwoParamsOneResult_Mock) Return(r0 error) {
+	_m._recv._TwoParamsOneResult_Return(r0)
+}
+
+func (_m _M0_TwoParamsOneResult_Mock) ReturnT(t testing.TB, r0 error) {
+	t.Helper()
+	_m._recv._TwoParamsOneResult_ReturnT(t, r0)
+}
+
+func (_m _M0_TwoParamsOneResult_Mock) Calls() []_M0_TwoParamsOneResult_Call {
+	return _m._recv._TwoParamsOneResult_Calls()
+}
+
+func (_m _M0_TwoParamsOneResult_Mock) All(t testing.TB) _M0_TwoParamsOneResult_AllMock {
+	return _M0_TwoParamsOneResult_AllMock{t}
+}
+
+type _M0_TwoParamsOneResult_AllMock struct{ t testing.TB }
+
+func (_m _M0_TwoParamsOneResult_AllMock) Do(fn func(pkg.String, pkg.String) error) {
+	_m.t.Helper()
+	new(M0)._TwoParamsOneResult_DoAll(_m.t, fn)
+}
+
+func (_m _M0_TwoParamsOneResult_AllMock) Stub() {
+	_m.t.Helper()
+	new(M0)._TwoParamsOneResult_StubAll(_m.t)
+}
+
+func (_m _M0_TwoParamsOneResult_AllMock) Return(r0 error) {
+	_m.t.Helper()
+	new(M0)._TwoParamsOneResult_ReturnAll(_m.t, r0)
+}
+
+func (_m _M0_TwoParamsOneResult_AllMock) Calls() []_M0_TwoParamsOneResult_Call {
+	return new(M0)._TwoParamsOneResult_AllCalls()
+}
+
+func (_m _M0_TwoParamsOneResult_AllMock) Bubble() {
+	_m.t.Helper()
+	new(M0)._TwoParamsOneResult_BubbleCalls(_m.t)
+}
+
+func (_m _M0_TwoParamsOneResult_AllMock) Record(limit int) {
+	_m.t.Helper()
+	new(M0)._TwoParamsOneResult_Record(_m.t, limit)
+}
+
+type _M0_TwoParamsTwoResults_Mock struct{ _recv *M0 }
+
+func (_m _M0_TwoParamsTwoResults_Mock) Do(fn func(pkg.String, pkg.String) (pkg.Int, error)) {
+	_m._recv._TwoParamsTwoResults_Do(fn)
+}
+
+func (_m _M0_TwoParamsTwoResults_Mock) DoT(t testing.TB, fn func(pkg.String, pkg.String) (pkg.Int, error)) {
+	t.Helper()
+	_m._recv._TwoParamsTwoResults_DoT(t, fn)
+}
+
+func (_m _M0_TwoParamsTwoResults_Mock) Stub() {
+	_m._recv._TwoParamsTwoResults_Stub()
+}
+
+func (_m _M0_TwoParamsTwoResults_Mock) StubT(t testing.TB) {
+	t.Helper()
+	_m._recv._TwoParamsTwoResults_StubT(t)
+}
+
+func (_m _M0_TwoParamsTwoResults_Mock) Return(r0 pkg.Int, r1 error) {
+	_m._recv._TwoParamsTwoResults_Return(r0, r1)
+}
+
+func (_m _M0_TwoParamsTwoResults_Mock) ReturnT(t testing.TB, r0 pkg.Int, r1 error) {
+	t.Helper()
+	_m._recv._TwoParamsTwoResults_ReturnT(t, r0, r1)
+}
+
+func (_m _M0_TwoParamsTwoResults_Mock) Calls() []_M0_TwoParamsTwoResults_Call {
+	return _m._recv._TwoParamsTwoResults_Calls()
+}
+
+func (_m _M0_TwoParamsTwoResults_Mock) All(t testing.TB) _M0_TwoParamsTwoResults_AllMock {
+	return _M0_TwoParamsTwoResults_AllMock{t}
+}
+
+type _M0_TwoParamsTwoResults_AllMock struct{ t testing.TB }
+
+func (_m _M0_TwoParamsTwoResults_AllMock) Do(fn func(pkg.String, pkg.String) (pkg.Int, error)) {
+	_m.t.Helper()
+	new(M0)._TwoParamsTwoResults_DoAll(_m.t, fn)
+}
+
+func (_m _M0_TwoParamsTwoResults_AllMock) Stub() {
+	_m.t.Helper()
+	new(M0)._TwoParamsTwoResults_StubAll(_m.t)
+}
+
+func (_m _M0_TwoParamsTwoResults_AllMock) Return(r0 pkg.Int, r1 error) {
+	_m.t.Helper()
+	new(M0)._TwoParamsTwoResults_ReturnAll(_m.t, r0, r1)
+}
+
+func (_m _M0_TwoParamsTwoResults_AllMock) Calls() []_M0_TwoParamsTwoResults_Call {
+	return new(M0)._TwoParamsTwoResults_AllCalls()
+}
+
+func (_m _M0_TwoParamsTwoResults_AllMock) Bubble() {
+	_m.t.Helper()
+	new(M0)._TwoParamsTwoResults_BubbleCalls(_m.t)
+}
+
+func (_m _M0_TwoParamsTwoResults_AllMock) Record(limit int) {
+	_m.t.Helper()
+	new(M0)._TwoParamsTwoResults_Record(_m.t, limit)
+}
+
+type _M0_TwoResults_Mock struct{ _recv *M0 }
+
+func (_m _M0_TwoResults_Mock) Do(fn func() (pkg.Int, error)) {
+	_m._recv._TwoResults_Do(fn)
+}
+
+func (_m _M0_TwoResults_Mock) DoT(t testing.TB, fn func() (pkg.Int, error)) {
+	t.Helper()
+	_m._recv._TwoResults_DoT(t, fn)
+}
+
+func (_m _M0_TwoResults_Mock) Stub() {
+	_m._recv._TwoResults_Stub()
+}
+
+func (_m _M0_TwoResults_Mock) StubT(t testing.TB) {
+	t.Helper()
+	_m._recv._TwoResults_StubT(t)
+}
+
+func (_m _M0_TwoResults_Mock) Return(r0 pkg.Int, r1 error) {
+	_m._recv._TwoResults_Return(r0, r1)
+}
+
+func (_m _M0_TwoResults_Mock) ReturnT(t testing.TB, r0 pkg.Int, r1 error) {
+	t.Helper()
+	_m._recv._TwoResults_ReturnT(t, r0, r1)
+}
+
+func (_m _M0_TwoResults_Mock) Calls() []_M0_TwoResults_Call {
+	return _m._recv._TwoResults_Calls()
+}
+
+func (_m _M0_TwoResults_Mock) All(t testing.TB) _M0_TwoResults_AllMock {
+	return _M0_TwoResults_AllMock{t}
+}
+
+type _M0_TwoResults_AllMock struct{ t testing.TB }
+
+func (_m _M0_TwoResults_AllMock) Do(fn func() (pkg.Int, error)) {
+	_m.t.Helper()
+	new(M0)._TwoResults_DoAll(_m.t, fn)
+}
+
+func (_m _M0_TwoResults_AllMock) Stub() {
+	_m.t.Helper()
+	new(M0)._TwoResults_StubAll(_m.t)
+}
+
+func (_m _M0_TwoResults_AllMock) Return(r0 pkg.Int, r1 error) {
+	_m.t.Helper()
+	new(M0)._TwoResults_ReturnAll(_m.t, r0, r1)
+}
+
+func (_m _M0_TwoResults_AllMock) Calls() []_M0_TwoResults_Call {
+	return new(M0)._TwoResults_AllCalls()
+}
+
+func (_m _M0_TwoResults_AllMock) Bubble() {
+	_m.t.Helper()
+	new(M0)._TwoResults_BubbleCalls(_m.t)
+}
+
+func (_m _M0_TwoResults_AllMock) Record(limit int) {
+	_m.t.Helper()
+	new(M0)._TwoResults_Record(_m.t, limit)
+}
+
+type _M0_VariadicNoResult_Mock struct{ _recv *M0 }
+
+func (_m _M0_VariadicNoResult_Mock) Do(fn func(...pkg.String)) {
+	_m._recv._VariadicNoResult_Do(fn)
+}
+
+func (_m _M0_VariadicNoResult_Mock) DoT(t testing.TB, fn func(...pkg.String)) {
+	t.Helper()
+	_m._recv._VariadicNoResult_DoT(t, fn)
+}
+
+func (_m _M0_VariadicNoResult_Mock) Stub() {
+	_m._recv._VariadicNoResult_Stub()
+}
+
+func (_m _M0_VariadicNoResult_Mock) StubT(t testing.TB) {
+	t.Helper()
+	_m._recv._VariadicNoResult_StubT(t)
+}
+
+func (_m _M0_VariadicNoResult_Mock) Return() {
+	_m._recv._VariadicNoResult_Return()
+}
+
+func (_m _M0_VariadicNoResult_Mock) ReturnT(t testing.TB) {
+	t.Helper()
+	_m._recv._VariadicNoResult_ReturnT(t)
+}
+
+func (_m _M0_VariadicNoResult_Mock) Calls() []_M0_VariadicNoResult_Call {
+	return _m._recv._VariadicNoResult_Calls()
+}
+
+func (_m _M0_VariadicNoResult_Mock) All(t testing.TB) _M0_VariadicNoResult_AllMock {
+	return _M0_VariadicNoResult_AllMock{t}
+}
+
+type _M0_VariadicNoResult_AllMock struct{ t testing.TB }
+
+func (_m _M0_VariadicNoResult_AllMock) Do(fn func(...pkg.String)) {
+	_m.t.Helper()
+	new(M0)._VariadicNoResult_DoAll(_m.t, fn)
+}
+
+func (_m _M0_VariadicNoResult_AllMock) Stub() {
+	_m.t.Helper()
+	new(M0)._VariadicNoResult_StubAll(_m.t)
+}
+
+func (_m _M0_VariadicNoResult_AllMock) Return() {
+	_m.t.Helper()
+	new(M0)._VariadicNoResult_ReturnAll(_m.t)
+}
+
+func (_m _M0_VariadicNoResult_AllMock) Calls() []_M0_VariadicNoResult_Call {
+	return new(M0)._VariadicNoResult_AllCalls()
+}
+
+func (_m _M0_VariadicNoResult_AllMock) Bubble() {
+	_m.t.Helper()
+	new(M0)._VariadicNoResult_BubbleCalls(_m.t)
+}
+
+func (_m _M0_VariadicNoResult_AllMock) Record(limit int) {
+	_m.t.Helper()
+	new(M0)._VariadicNoResult_Record(_m.t, limit)
+}
+
+type _M0_VariadicOneResult_Mock struct{ _recv *M0 }
+
+func (_m _M0_VariadicOneResult_Mock) Do(fn func(...pkg.String) error) {
+	_m._recv._VariadicOneResult_Do(fn)
+}
+
+func (_m _M0_VariadicOneResult_Mock) DoT(t testing.TB, fn func(...pkg.String) error) {
+	t.Helper()
+	_m._recv._VariadicOneResult_DoT(t, fn)
+}
+
+func (_m _M0_VariadicOneResult_Mock) Stub() {
+	_m._recv._VariadicOneResult_Stub()
+}
+
+func (_m _M0_VariadicOneResult_Mock) StubT(t testing.TB) {
+	t.Helper()
+	_m._recv._VariadicOneResult_StubT(t)
+}
+
+func (_m _M0_VariadicOneResult_Mock) Return(r0 error) {
+	_m._recv._VariadicOneResult_Return(r0)
+}
+
+func (_m _M0_VariadicOneResult_Mock) ReturnT(t testing.TB, r0 error) {
+	t.Helper()
+	_m._recv._VariadicOneResult_ReturnT(t, r0)
+}
+
+func (_m _M0_VariadicOneResult_Mock) Calls() []_M0_VariadicOneResult_Call {
+	return _m._recv._VariadicOneResult_Calls()
+}
+
+func (_m _M0_VariadicOneResult_Mock) All(t testing.TB) _M0_VariadicOneResult_AllMock {
+	return _M0_VariadicOneResult_AllMock{t}
+}
+
+type _M0_VariadicOneResult_AllMock struct{ t testing.TB }
+
+func (_m _M0_VariadicOneResult_AllMock) Do(fn func(...pkg.String) error) {
+	_m.t.Helper()
+	new(M0)._VariadicOneResult_DoAll(_m.t, fn)
+}
+
+func (_m _M0_VariadicOneResult_AllMock) Stub() {
+	_m.t.Helper()
+	new(M0)._VariadicOneResult_StubAll(_m.t)
+}
+
+func (_m _M0_VariadicOneResult_AllMock) Return(r0 error) {
+	_m.t.Helper()
+	new(M0)._VariadicOneResult_ReturnAll(_m.t, r0)
+}
+
+func (_m _M0_VariadicOneResult_AllMock) Calls() []_M0_VariadicOneResult_Call {
+	return new(M0)._VariadicOneResult_AllCalls()
+}
+
+func (_m _M0_VariadicOneResult_AllMock) Bubble() {
+	_m.t.Helper()
+	new(M0)._VariadicOneResult_BubbleCalls(_m.t)
+}
+
+func (_m _M0_VariadicOneResult_AllMock) Record(limit int) {
+	_m.t.Helper()
+	new(M0)._VariadicOneResult_Record(_m.t, limit)
+}
+
+type _M0_VariadicTwoResults_Mock struct{ _recv *M0 }
+
+func (_m _M0_VariadicTwoResults_Mock) Do(fn func(...pkg.String) (pkg.Int, error)) {
+	_m._recv._VariadicTwoResults_Do(fn)
+}
+
+func (_m _M0_VariadicTwoResults_Mock) DoT(t testing.TB, fn func(...pkg.String) (pkg.Int, error)) {
+	t.Helper()
+	_m._recv._VariadicTwoResults_DoT(t, fn)
+}
+
+func (_m _M0_VariadicTwoResults_Mock) Stub() {
+	_m._recv._VariadicTwoResults_Stub()
+}
+
+func (_m _M0_VariadicTwoResults_Mock) StubT(t testing.TB) {
+	t.Helper()
+	_m._recv._VariadicTwoResults_StubT(t)
+}
+
+func (_m _M0_VariadicTwoResults_Mock) Return(r0 pkg.Int, r1 error) {
+	_m._recv._VariadicTwoResults_Return(r0, r1)
+}
+
+func (_m _M0_VariadicTwoResults_Mock) ReturnT(t testing.TB, r0 pkg.Int, r1 error) {
+	t.Helper()
+	_m._recv._VariadicTwoResults_ReturnT(t, r0, r1)
+}
+
+func (_m _M0_VariadicTwoResults_Mock) Calls() []_M0_VariadicTwoResults_Call {
+	return _m._recv._VariadicTwoResults_Calls()
+}
+
+func (_m _M0_VariadicTwoResults_Mock) All(t testing.TB) _M0_VariadicTwoResults_AllMock {
+	return _M0_VariadicTwoResults_AllMock{t}
+}
+
+type _M0_VariadicTwoResults_AllMock struct{ t testing.TB }
+
+func (_m _M0_VariadicTwoResults_AllMock) Do(fn func(...pkg.String) (pkg.Int, error)) {
+	_m.t.Helper()
+	new(M0)._VariadicTwoResults_DoAll(_m.t, fn)
+}
+
+func (_m _M0_VariadicTwoResults_AllMock) Stub() {
+	_m.t.Helper()
+	new(M0)._VariadicTwoResults_StubAll(_m.t)
+}
+
+func (_m _M0_VariadicTwoResults_AllMock) Return(r0 pkg.Int, r1 error) {
+	_m.t.Helper()
+	new(M0)._VariadicTwoResults_ReturnAll(_m.t, r0, r1)
+}
+
+func (_m _M0_VariadicTwoResults_AllMock) Calls() []_M0_VariadicTwoResults_Call {
+	return new(M0)._VariadicTwoResults_AllCalls()
+}
+
+func (_m _M0_VariadicTwoResults_AllMock) Bubble() {
+	_m.t.Helper()
+	new(M0)._VariadicTwoResults_BubbleCalls(_m.t)
+}
+
+func (_m _M0_VariadicTwoResults_AllMock) Record(limit int) {
+	_m.t.Helper()
+	new(M0)._VariadicTwoResults_Record(_m.t, limit)
+}
+
+type _M0_Write_Mock struct{ _recv *M0 }
+
+func (_m _M0_Write_Mock) Do(fn func([]byte) (int, error)) {
+	_m._recv._Write_Do(fn)
+}
+
+func (_m _M0_Write_Mock) DoT(t testing.TB, fn func([]byte) (int, error)) {
+	t.Helper()
+	_m._recv._Write_DoT(t, fn)
+}
+
+func (_m _M0_Write_Mock) Stub() {
+	_m._recv._Write_Stub()
+}
+
+func (_m _M0_Write_Mock) StubT(t testing.TB) {
+	t.Helper()
+	_m._recv._Write_StubT(t)
+}
+
+func (_m _M0_Write_Mock) Return(n int, err error) {
+	_m._recv._Write_Return(n, err)
+}
+
+func (_m _M0_Write_Mock) ReturnT(t testing.TB, n int, err error) {
+	t.Helper()
+	_m._recv._Write_ReturnT(t, n, err)
+}
+
+func (_m _M0_Write_Mock) Calls() []_M0_Write_Call {
+	return _m._recv._Write_Calls()
+}
+
+func (_m _M0_Write_Mock) All(t testing.TB) _M0_Write_AllMock {
+	return _M0_Write_AllMock{t}
+}
+
+type _M0_Write_AllMock struct{ t testing.TB }
+
+func (_m _M0_Write_AllMock) Do(fn func([]byte) (int, error)) {
+	_m.t.Helper()
+	new(M0)._Write_DoAll(_m.t, fn)
+}
+
+func (_m _M0_Write_AllMock) Stub() {
+	_m.t.Helper()
+	new(M0)._Write_StubAll(_m.t)
+}
+
+func (_m _M0_Write_AllMock) Return(n int, err error) {
+	_m.t.Helper()
+	new(M0)._Write_ReturnAll(_m.t, n, err)
+}
+
+func (_m _M0_Write_AllMock) Calls() []_M0_Write_Call {
+	return new(M0)._Write_AllCalls()
+}
+
+func (_m _M0_Write_AllMock) Bubble() {
+	_m.t.Helper()
+	new(M0)._Write_BubbleCalls(_m.t)
+}
+
+func (_m _M0_Write_AllMock) Record(limit int) {
+	_m.t.Helper()
+	new(M0)._Write_Record(_m.t, limit)
 }
@@ -0,0 +1,163 @@
+// Code generated by lesiw.io/moxie. DO NOT EDIT.
+
+package testdata
+
+import (
+	"strconv"
+	"testing"
+
+	"lesiw.io/moxie/mock"
+
+	"lesiw.io/moxie/internal/testdata/pkg"
+)
+
+var (
+	_M1       mock.Registry[M1, _M1Data]
+	_M1Policy _M1Policies
+)
+
+type _M1Data struct {
+	Value mock.Method[func() pkg.Int, _M1_Value_Call]
+}
+
+type _M1Policies struct {
+	Value mock.Policy
+}
+
+func _M1Entry(t *M1) *mock.Entry[_M1Data] {
+	if t == nil {
+		return _M1.Global()
+	}
+	return _M1.State(&t.moxie)
+}
+
+func _M1Instance(t *M1, name string) *mock.Entry[_M1Data] {
+	if t == nil {
+		panic(name + ": nil pointer receiver")
+	}
+	return _M1Entry(t)
+}
+
+func (_recv *M1) _M1_Reset() {
+	_M1Instance(_recv, "M1").Reset()
+}
+
+func (M1) _M1_ResetAll() {
+	_M1.Global().Reset()
+}
+
+func (M1) _M1_BubbleAll(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M1")
+	mock.Bubble(t, _M1.Global(), (*_M1Data).clearCalls, _M1Policy.all()...)
+}
+
+func (M1) _M1_RecordAll(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M1")
+	for _, p := range _M1Policy.all() {
+		p.Record(t, limit)
+	}
+}
+
+func (_dat *_M1Data) clearCalls() {
+	_dat.Value.Calls.Clear()
+}
+
+func (_p *_M1Policies) all() []*mock.Policy {
+	return []*mock.Policy{
+		&_p.Value,
+	}
+}
+
+func init() {
+	_M1Policy.Value.SetLimit(2)
+}
+
+type _M1_Value_Call struct{}
+
+func (_dat *_M1Data) _Value() *mock.Method[func() pkg.Int, _M1_Value_Call] {
+	return &_dat.Value
+}
+
+func (_recv *M1) Value() pkg.Int {
+	if _recv == nil {
+		panic("M1.Value: nil pointer receiver")
+	}
+	if _M1.Idle(&_M1Policy.Value) {
+		return _recv.T1.Value()
+	}
+	_fn, _ok := mock.Next(_M1Entry(_recv), _M1.Global(), (*_M1Data)._Value, &_M1Policy.Value, _M1_Value_Call{})
+	if !_ok {
+		_fn = _recv.T1.Value
+	}
+	return _fn()
+}
+
+func (_recv *M1) _Value_Do(fn func() pkg.Int) {
+	mock.Do(_M1Instance(_recv, "M1.Value"), (*_M1Data)._Value, fn)
+}
+
+func (_recv *M1) _Value_DoT(t testing.TB, fn func() pkg.Int) {
+	mock.DoT(t, _M1Instance(_recv, "M1.Value"), (*_M1Data)._Value, fn)
+}
+
+func (M1) _Value_DoAll(t testing.TB, fn func() pkg.Int) {
+	t.Helper()
+	mock.Serial(t, "M1.Value")
+	mock.DoAll(t, _M1.Global(), (*_M1Data)._Value, fn)
+}
+
+func (_recv *M1) _Value_Stub() {
+	_recv._Value_Do(func() (r0 pkg.Int) { return })
+}
+
+func (_recv *M1) _Value_StubT(t testing.TB) {
+	_recv._Value_DoT(t, func() (r0 pkg.Int) { return })
+}
+
+func (M1) _Value_StubAll(t testing.TB) {
+	t.Helper()
+	new(M1)._Value_DoAll(t, func() (r0 pkg.Int) { return })
+}
+
+func (_recv *M1) _Value_Return(r0 pkg.Int) {
+	_recv._Value_Do(func() pkg.Int { return r0 })
+}
+
+func (_recv *M1) _Value_ReturnT(t testing.TB, r0 pkg.Int) {
+	_recv._Value_DoT(t, func() pkg.Int { return r0 })
+}
+
+func (M1) _Value_ReturnAll(t testing.TB, r0 pkg.Int) {
+	t.Helper()
+	new(M1)._Value_DoAll(t, func() pkg.Int { return r0 })
+}
+
+func (_recv *M1) _Value_Calls() []_M1_Value_Call {
+	return mock.Calls(_M1Instance(_recv, "M1.Value"), (*_M1Data)._Value)
+}
+
+func (M1) _Value_AllCalls() []_M1_Value_Call {
+	return mock.Calls(_M1.Global(), (*_M1Data)._Value)
+}
+
+func (M1) _Value_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M1.Value")
+	mock.Bubble(t, _M1.Global(), func(_dat *_M1Data) { _dat.Value.Calls.Clear() }, &_M1Policy.Value)
+}
+
+func (M1) _Value_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M1.Value")
+	_M1Policy.Value.Record(t, limit)
+}
+
+func (_recv *M1) _Value_AssertCalls(t testing.TB, want int) {
+	t.Helper()
+	if got := len(_recv._Value_Calls()); got != want {
+		t.Errorf("M1.Value: want %s calls, got %d",
+			strconv.Itoa(want), got)
+	}
+}
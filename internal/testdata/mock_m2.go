@@ -0,0 +1,227 @@
+// Code generated by lesiw.io/moxie. DO NOT EDIT.
+
+//go:build moxie
+
+package testdata
+
+import (
+	"testing"
+
+	"lesiw.io/moxie/mock"
+
+	"lesiw.io/moxie/internal/testdata/pkg"
+)
+
+var (
+	_M2       mock.Registry[M2, _M2Data]
+	_M2Policy _M2Policies
+)
+
+type _M2Data struct {
+	Value mock.Method[func() pkg.Int, M2_Value_Call]
+}
+
+type _M2Policies struct {
+	Value mock.Policy
+}
+
+func _M2Entry(t *M2) *mock.Entry[_M2Data] {
+	return _M2.Get(t)
+}
+
+func _M2Instance(t *M2, name string) *mock.Entry[_M2Data] {
+	if t == nil {
+		panic(name + ": nil pointer receiver")
+	}
+	return _M2Entry(t)
+}
+
+func (_recv *M2) M2_Reset() {
+	_M2Instance(_recv, "M2").Reset()
+}
+
+func (M2) M2_ResetAll() {
+	_M2.Global().Reset()
+}
+
+func (M2) M2_BubbleAll(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M2")
+	mock.Bubble(t, _M2.Global(), (*_M2Data).clearCalls, _M2Policy.all()...)
+}
+
+func (M2) M2_RecordAll(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M2")
+	for _, p := range _M2Policy.all() {
+		p.Record(t, limit)
+	}
+}
+
+func (_dat *_M2Data) clearCalls() {
+	_dat.Value.Calls.Clear()
+}
+
+func (_p *_M2Policies) all() []*mock.Policy {
+	return []*mock.Policy{
+		&_p.Value,
+	}
+}
+
+type M2_Value_Call struct{}
+
+func (_dat *_M2Data) _Value() *mock.Method[func() pkg.Int, M2_Value_Call] {
+	return &_dat.Value
+}
+
+func (_recv *M2) Value() pkg.Int {
+	if _recv == nil {
+		panic("M2.Value: nil pointer receiver")
+	}
+	if _M2.Idle(&_M2Policy.Value) {
+		return _recv.T1.Value()
+	}
+	_fn, _ok := mock.Next(_M2Entry(_recv), _M2.Global(), (*_M2Data)._Value, &_M2Policy.Value, M2_Value_Call{})
+	if !_ok {
+		_fn = _recv.T1.Value
+	}
+	return _fn()
+}
+
+func (_recv *M2) Value_Do(fn func() pkg.Int) {
+	mock.Do(_M2Instance(_recv, "M2.Value"), (*_M2Data)._Value, fn)
+}
+
+func (_recv *M2) Value_DoT(t testing.TB, fn func() pkg.Int) {
+	mock.DoT(t, _M2Instance(_recv, "M2.Value"), (*_M2Data)._Value, fn)
+}
+
+func (M2) Value_DoAll(t testing.TB, fn func() pkg.Int) {
+	t.Helper()
+	mock.Serial(t, "M2.Value")
+	mock.DoAll(t, _M2.Global(), (*_M2Data)._Value, fn)
+}
+
+func (_recv *M2) Value_Stub() {
+	_recv.Value_Do(func() (r0 pkg.Int) { return })
+}
+
+func (_recv *M2) Value_StubT(t testing.TB) {
+	_recv.Value_DoT(t, func() (r0 pkg.Int) { return })
+}
+
+func (M2) Value_StubAll(t testing.TB) {
+	t.Helper()
+	new(M2).Value_DoAll(t, func() (r0 pkg.Int) { return })
+}
+
+func (_recv *M2) Value_Return(r0 pkg.Int) {
+	_recv.Value_Do(func() pkg.Int { return r0 })
+}
+
+func (_recv *M2) Value_ReturnT(t testing.TB, r0 pkg.Int) {
+	_recv.Value_DoT(t, func() pkg.Int { return r0 })
+}
+
+func (M2) Value_ReturnAll(t testing.TB, r0 pkg.Int) {
+	t.Helper()
+	new(M2).Value_DoAll(t, func() pkg.Int { return r0 })
+}
+
+func (_recv *M2) Value_Calls() []M2_Value_Call {
+	return mock.Calls(_M2Instance(_recv, "M2.Value"), (*_M2Data)._Value)
+}
+
+func (M2) Value_AllCalls() []M2_Value_Call {
+	return mock.Calls(_M2.Global(), (*_M2Data)._Value)
+}
+
+func (M2) Value_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M2.Value")
+	mock.Bubble(t, _M2.Global(), func(_dat *_M2Data) { _dat.Value.Calls.Clear() }, &_M2Policy.Value)
+}
+
+func (M2) Value_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M2.Value")
+	_M2Policy.Value.Record(t, limit)
+}
+
+type M2Mock struct {
+	Value M2_Value_Mock
+}
+
+func (_recv *M2) Mock() *M2Mock {
+	return &M2Mock{
+		Value: M2_Value_Mock{_recv},
+	}
+}
+
+type M2_Value_Mock struct{ _recv *M2 }
+
+func (_m M2_Value_Mock) Do(fn func() pkg.Int) {
+	_m._recv.Value_Do(fn)
+}
+
+func (_m M2_Value_Mock) DoT(t testing.TB, fn func() pkg.Int) {
+	t.Helper()
+	_m._recv.Value_DoT(t, fn)
+}
+
+func (_m M2_Value_Mock) Stub() {
+	_m._recv.Value_Stub()
+}
+
+func (_m M2_Value_Mock) StubT(t testing.TB) {
+	t.Helper()
+	_m._recv.Value_StubT(t)
+}
+
+func (_m M2_Value_Mock) Return(r0 pkg.Int) {
+	_m._recv.Value_Return(r0)
+}
+
+func (_m M2_Value_Mock) ReturnT(t testing.TB, r0 pkg.Int) {
+	t.Helper()
+	_m._recv.Value_ReturnT(t, r0)
+}
+
+func (_m M2_Value_Mock) Calls() []M2_Value_Call {
+	return _m._recv.Value_Calls()
+}
+
+func (_m M2_Value_Mock) All(t testing.TB) M2_Value_AllMock {
+	return M2_Value_AllMock{t}
+}
+
+type M2_Value_AllMock struct{ t testing.TB }
+
+func (_m M2_Value_AllMock) Do(fn func() pkg.Int) {
+	_m.t.Helper()
+	new(M2).Value_DoAll(_m.t, fn)
+}
+
+func (_m M2_Value_AllMock) Stub() {
+	_m.t.Helper()
+	new(M2).Value_StubAll(_m.t)
+}
+
+func (_m M2_Value_AllMock) Return(r0 pkg.Int) {
+	_m.t.Helper()
+	new(M2).Value_ReturnAll(_m.t, r0)
+}
+
+func (_m M2_Value_AllMock) Calls() []M2_Value_Call {
+	return new(M2).Value_AllCalls()
+}
+
+func (_m M2_Value_AllMock) Bubble() {
+	_m.t.Helper()
+	new(M2).Value_BubbleCalls(_m.t)
+}
+
+func (_m M2_Value_AllMock) Record(limit int) {
+	_m.t.Helper()
+	new(M2).Value_Record(_m.t, limit)
+}
@@ -0,0 +1,327 @@
+// Code generated by lesiw.io/moxie. DO NOT EDIT.
+
+package testdata
+
+import (
+	"testing"
+
+	"lesiw.io/moxie/mock"
+)
+
+var (
+	_M3       mock.Registry[M3, _M3Data]
+	_M3Policy _M3Policies
+)
+
+type _M3Data struct {
+	Close mock.Method[func() error, _M3_Close_Call]
+	Read  mock.Method[func([]byte) (int, error), _M3_Read_Call]
+	Write mock.Method[func([]byte) (int, error), _M3_Write_Call]
+}
+
+type _M3Policies struct {
+	Close mock.Policy
+	Read  mock.Policy
+	Write mock.Policy
+}
+
+func _M3Entry(t *M3) *mock.Entry[_M3Data] {
+	return _M3.Get(t)
+}
+
+func _M3Instance(t *M3, name string) *mock.Entry[_M3Data] {
+	if t == nil {
+		panic(name + ": nil pointer receiver")
+	}
+	return _M3Entry(t)
+}
+
+func (_recv *M3) _M3_Reset() {
+	_M3Instance(_recv, "M3").Reset()
+}
+
+func (M3) _M3_ResetAll() {
+	_M3.Global().Reset()
+}
+
+func (M3) _M3_BubbleAll(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M3")
+	mock.Bubble(t, _M3.Global(), (*_M3Data).clearCalls, _M3Policy.all()...)
+}
+
+func (M3) _M3_RecordAll(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M3")
+	for _, p := range _M3Policy.all() {
+		p.Record(t, limit)
+	}
+}
+
+func (_dat *_M3Data) clearCalls() {
+	_dat.Close.Calls.Clear()
+	_dat.Read.Calls.Clear()
+	_dat.Write.Calls.Clear()
+}
+
+func (_p *_M3Policies) all() []*mock.Policy {
+	return []*mock.Policy{
+		&_p.Close,
+		&_p.Read,
+		&_p.Write,
+	}
+}
+
+type _M3_Close_Call struct{}
+type _M3_Read_Call struct {
+	P []byte
+}
+type _M3_Write_Call struct {
+	P []byte
+}
+
+func (_dat *_M3Data) _Close() *mock.Method[func() error, _M3_Close_Call] {
+	return &_dat.Close
+}
+
+func (_recv *M3) Close() error {
+	if _recv == nil {
+		panic("M3.Close: nil pointer receiver")
+	}
+	if _M3.Idle(&_M3Policy.Close) &&
+		_recv.ReadCloser != nil {
+		return _recv.ReadCloser.Close()
+	}
+	_fn, _ok := mock.Next(_M3Entry(_recv), _M3.Global(), (*_M3Data)._Close, &_M3Policy.Close, _M3_Close_Call{})
+	if !_ok {
+		if _recv.ReadCloser == nil {
+			panic("M3.Close: not mocked and embedded io.ReadCloser is nil")
+		}
+		_fn = _recv.ReadCloser.Close
+	}
+	return _fn()
+}
+
+func (_recv *M3) _Close_Do(fn func() error) {
+	mock.Do(_M3Instance(_recv, "M3.Close"), (*_M3Data)._Close, fn)
+}
+
+func (_recv *M3) _Close_DoT(t testing.TB, fn func() error) {
+	mock.DoT(t, _M3Instance(_recv, "M3.Close"), (*_M3Data)._Close, fn)
+}
+
+func (M3) _Close_DoAll(t testing.TB, fn func() error) {
+	t.Helper()
+	mock.Serial(t, "M3.Close")
+	mock.DoAll(t, _M3.Global(), (*_M3Data)._Close, fn)
+}
+
+func (_recv *M3) _Close_Stub() {
+	_recv._Close_Do(func() (r0 error) { return })
+}
+
+func (_recv *M3) _Close_StubT(t testing.TB) {
+	_recv._Close_DoT(t, func() (r0 error) { return })
+}
+
+func (M3) _Close_StubAll(t testing.TB) {
+	t.Helper()
+	new(M3)._Close_DoAll(t, func() (r0 error) { return })
+}
+
+func (_recv *M3) _Close_Return(r0 error) {
+	_recv._Close_Do(func() error { return r0 })
+}
+
+func (_recv *M3) _Close_ReturnT(t testing.TB, r0 error) {
+	_recv._Close_DoT(t, func() error { return r0 })
+}
+
+func (M3) _Close_ReturnAll(t testing.TB, r0 error) {
+	t.Helper()
+	new(M3)._Close_DoAll(t, func() error { return r0 })
+}
+
+func (_recv *M3) _Close_Calls() []_M3_Close_Call {
+	return mock.Calls(_M3Instance(_recv, "M3.Close"), (*_M3Data)._Close)
+}
+
+func (M3) _Close_AllCalls() []_M3_Close_Call {
+	return mock.Calls(_M3.Global(), (*_M3Data)._Close)
+}
+
+func (M3) _Close_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M3.Close")
+	mock.Bubble(t, _M3.Global(), func(_dat *_M3Data) { _dat.Close.Calls.Clear() }, &_M3Policy.Close)
+}
+
+func (M3) _Close_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M3.Close")
+	_M3Policy.Close.Record(t, limit)
+}
+
+func (_dat *_M3Data) _Read() *mock.Method[func([]byte) (int, error), _M3_Read_Call] {
+	return &_dat.Read
+}
+
+func (_recv *M3) Read(p []byte) (int, error) {
+	if _recv == nil {
+		panic("M3.Read: nil pointer receiver")
+	}
+	if _M3.Idle(&_M3Policy.Read) &&
+		_recv.ReadCloser != nil {
+		return _recv.ReadCloser.Read(p)
+	}
+	_fn, _ok := mock.Next(_M3Entry(_recv), _M3.Global(), (*_M3Data)._Read, &_M3Policy.Read, _M3_Read_Call{p})
+	if !_ok {
+		if _recv.ReadCloser == nil {
+			panic("M3.Read: not mocked and embedded io.ReadCloser is nil")
+		}
+		_fn = _recv.ReadCloser.Read
+	}
+	return _fn(p)
+}
+
+func (_recv *M3) _Read_Do(fn func([]byte) (int, error)) {
+	mock.Do(_M3Instance(_recv, "M3.Read"), (*_M3Data)._Read, fn)
+}
+
+func (_recv *M3) _Read_DoT(t testing.TB, fn func([]byte) (int, error)) {
+	mock.DoT(t, _M3Instance(_recv, "M3.Read"), (*_M3Data)._Read, fn)
+}
+
+func (M3) _Read_DoAll(t testing.TB, fn func([]byte) (int, error)) {
+	t.Helper()
+	mock.Serial(t, "M3.Read")
+	mock.DoAll(t, _M3.Global(), (*_M3Data)._Read, fn)
+}
+
+func (_recv *M3) _Read_Stub() {
+	_recv._Read_Do(func([]byte) (n int, err error) { return })
+}
+
+func (_recv *M3) _Read_StubT(t testing.TB) {
+	_recv._Read_DoT(t, func([]byte) (n int, err error) { return })
+}
+
+func (M3) _Read_StubAll(t testing.TB) {
+	t.Helper()
+	new(M3)._Read_DoAll(t, func([]byte) (n int, err error) { return })
+}
+
+func (_recv *M3) _Read_Return(n int, err error) {
+	_recv._Read_Do(func([]byte) (int, error) { return n, err })
+}
+
+func (_recv *M3) _Read_ReturnT(t testing.TB, n int, err error) {
+	_recv._Read_DoT(t, func([]byte) (int, error) { return n, err })
+}
+
+func (M3) _Read_ReturnAll(t testing.TB, n int, err error) {
+	t.Helper()
+	new(M3)._Read_DoAll(t, func([]byte) (int, error) { return n, err })
+}
+
+func (_recv *M3) _Read_Calls() []_M3_Read_Call {
+	return mock.Calls(_M3Instance(_recv, "M3.Read"), (*_M3Data)._Read)
+}
+
+func (M3) _Read_AllCalls() []_M3_Read_Call {
+	return mock.Calls(_M3.Global(), (*_M3Data)._Read)
+}
+
+func (M3) _Read_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M3.Read")
+	mock.Bubble(t, _M3.Global(), func(_dat *_M3Data) { _dat.Read.Calls.Clear() }, &_M3Policy.Read)
+}
+
+func (M3) _Read_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M3.Read")
+	_M3Policy.Read.Record(t, limit)
+}
+
+func (_dat *_M3Data) _Write() *mock.Method[func([]byte) (int, error), _M3_Write_Call] {
+	return &_dat.Write
+}
+
+func (_recv *M3) Write(p []byte) (int, error) {
+	if _recv == nil {
+		panic("M3.Write: nil pointer receiver")
+	}
+	if _M3.Idle(&_M3Policy.Write) &&
+		_recv.WriteCloser != nil {
+		return _recv.WriteCloser.Write(p)
+	}
+	_fn, _ok := mock.Next(_M3Entry(_recv), _M3.Global(), (*_M3Data)._Write, &_M3Policy.Write, _M3_Write_Call{p})
+	if !_ok {
+		if _recv.WriteCloser == nil {
+			panic("M3.Write: not mocked and embedded io.WriteCloser is nil")
+		}
+		_fn = _recv.WriteCloser.Write
+	}
+	return _fn(p)
+}
+
+func (_recv *M3) _Write_Do(fn func([]byte) (int, error)) {
+	mock.Do(_M3Instance(_recv, "M3.Write"), (*_M3Data)._Write, fn)
+}
+
+func (_recv *M3) _Write_DoT(t testing.TB, fn func([]byte) (int, error)) {
+	mock.DoT(t, _M3Instance(_recv, "M3.Write"), (*_M3Data)._Write, fn)
+}
+
+func (M3) _Write_DoAll(t testing.TB, fn func([]byte) (int, error)) {
+	t.Helper()
+	mock.Serial(t, "M3.Write")
+	mock.DoAll(t, _M3.Global(), (*_M3Data)._Write, fn)
+}
+
+func (_recv *M3) _Write_Stub() {
+	_recv._Write_Do(func([]byte) (n int, err error) { return })
+}
+
+func (_recv *M3) _Write_StubT(t testing.TB) {
+	_recv._Write_DoT(t, func([]byte) (n int, err error) { return })
+}
+
+func (M3) _Write_StubAll(t testing.TB) {
+	t.Helper()
+	new(M3)._Write_DoAll(t, func([]byte) (n int, err error) { return })
+}
+
+func (_recv *M3) _Write_Return(n int, err error) {
+	_recv._Write_Do(func([]byte) (int, error) { return n, err })
+}
+
+func (_recv *M3) _Write_ReturnT(t testing.TB, n int, err error) {
+	_recv._Write_DoT(t, func([]byte) (int, error) { return n, err })
+}
+
+func (M3) _Write_ReturnAll(t testing.TB, n int, err error) {
+	t.Helper()
+	new(M3)._Write_DoAll(t, func([]byte) (int, error) { return n, err })
+}
+
+func (_recv *M3) _Write_Calls() []_M3_Write_Call {
+	return mock.Calls(_M3Instance(_recv, "M3.Write"), (*_M3Data)._Write)
+}
+
+func (M3) _Write_AllCalls() []_M3_Write_Call {
+	return mock.Calls(_M3.Global(), (*_M3Data)._Write)
+}
+
+func (M3) _Write_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M3.Write")
+	mock.Bubble(t, _M3.Global(), func(_dat *_M3Data) { _dat.Write.Calls.Clear() }, &_M3Policy.Write)
+}
+
+func (M3) _Write_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M3.Write")
+	_M3Policy.Write.Record(t, limit)
+}
@@ -0,0 +1,236 @@
+// Code generated by lesiw.io/moxie. DO NOT EDIT.
+
+package testdata
+
+import (
+	"testing"
+
+	"lesiw.io/moxie/mock"
+)
+
+var (
+	_M4       mock.Registry[M4, _M4Data]
+	_M4Policy _M4Policies
+)
+
+type _M4Data struct {
+	Read     mock.Method[func([]byte) (int, error), _M4_Read_Call]
+	Validate mock.Method[func(string) error, _M4_Validate_Call]
+}
+
+type _M4Policies struct {
+	Read     mock.Policy
+	Validate mock.Policy
+}
+
+func _M4Entry(t *M4) *mock.Entry[_M4Data] {
+	return _M4.Get(t)
+}
+
+func _M4Instance(t *M4, name string) *mock.Entry[_M4Data] {
+	if t == nil {
+		panic(name + ": nil pointer receiver")
+	}
+	return _M4Entry(t)
+}
+
+func (_recv *M4) _M4_Reset() {
+	_M4Instance(_recv, "M4").Reset()
+}
+
+func (M4) _M4_ResetAll() {
+	_M4.Global().Reset()
+}
+
+func (M4) _M4_BubbleAll(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M4")
+	mock.Bubble(t, _M4.Global(), (*_M4Data).clearCalls, _M4Policy.all()...)
+}
+
+func (M4) _M4_RecordAll(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M4")
+	for _, p := range _M4Policy.all() {
+		p.Record(t, limit)
+	}
+}
+
+func (_dat *_M4Data) clearCalls() {
+	_dat.Read.Calls.Clear()
+	_dat.Validate.Calls.Clear()
+}
+
+func (_p *_M4Policies) all() []*mock.Policy {
+	return []*mock.Policy{
+		&_p.Read,
+		&_p.Validate,
+	}
+}
+
+type _M4_Read_Call struct {
+	P []byte
+}
+type _M4_Validate_Call struct {
+	S string
+}
+
+func (_dat *_M4Data) _Read() *mock.Method[func([]byte) (int, error), _M4_Read_Call] {
+	return &_dat.Read
+}
+
+func (_recv *M4) Read(p []byte) (int, error) {
+	if _recv == nil {
+		panic("M4.Read: nil pointer receiver")
+	}
+	if _M4.Idle(&_M4Policy.Read) &&
+		_recv.ReadCloser != nil {
+		return _recv.ReadCloser.Read(p)
+	}
+	_fn, _ok := mock.Next(_M4Entry(_recv), _M4.Global(), (*_M4Data)._Read, &_M4Policy.Read, _M4_Read_Call{p})
+	if !_ok {
+		if _recv.ReadCloser == nil {
+			panic("M4.Read: not mocked and embedded io.ReadCloser is nil")
+		}
+		_fn = _recv.ReadCloser.Read
+	}
+	return _fn(p)
+}
+
+func (_recv *M4) _Read_Do(fn func([]byte) (int, error)) {
+	mock.Do(_M4Instance(_recv, "M4.Read"), (*_M4Data)._Read, fn)
+}
+
+func (_recv *M4) _Read_DoT(t testing.TB, fn func([]byte) (int, error)) {
+	mock.DoT(t, _M4Instance(_recv, "M4.Read"), (*_M4Data)._Read, fn)
+}
+
+func (M4) _Read_DoAll(t testing.TB, fn func([]byte) (int, error)) {
+	t.Helper()
+	mock.Serial(t, "M4.Read")
+	mock.DoAll(t, _M4.Global(), (*_M4Data)._Read, fn)
+}
+
+func (_recv *M4) _Read_Stub() {
+	_recv._Read_Do(func([]byte) (n int, err error) { return })
+}
+
+func (_recv *M4) _Read_StubT(t testing.TB) {
+	_recv._Read_DoT(t, func([]byte) (n int, err error) { return })
+}
+
+func (M4) _Read_StubAll(t testing.TB) {
+	t.Helper()
+	new(M4)._Read_DoAll(t, func([]byte) (n int, err error) { return })
+}
+
+func (_recv *M4) _Read_Return(n int, err error) {
+	_recv._Read_Do(func([]byte) (int, error) { return n, err })
+}
+
+func (_recv *M4) _Read_ReturnT(t testing.TB, n int, err error) {
+	_recv._Read_DoT(t, func([]byte) (int, error) { return n, err })
+}
+
+func (M4) _Read_ReturnAll(t testing.TB, n int, err error) {
+	t.Helper()
+	new(M4)._Read_DoAll(t, func([]byte) (int, error) { return n, err })
+}
+
+func (_recv *M4) _Read_Calls() []_M4_Read_Call {
+	return mock.Calls(_M4Instance(_recv, "M4.Read"), (*_M4Data)._Read)
+}
+
+func (M4) _Read_AllCalls() []_M4_Read_Call {
+	return mock.Calls(_M4.Global(), (*_M4Data)._Read)
+}
+
+func (M4) _Read_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M4.Read")
+	mock.Bubble(t, _M4.Global(), func(_dat *_M4Data) { _dat.Read.Calls.Clear() }, &_M4Policy.Read)
+}
+
+func (M4) _Read_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M4.Read")
+	_M4Policy.Read.Record(t, limit)
+}
+
+func (_dat *_M4Data) _Validate() *mock.Method[func(string) error, _M4_Validate_Call] {
+	return &_dat.Validate
+}
+
+func (_recv *M4) Validate(s string) error {
+	if _recv == nil {
+		panic("M4.Validate: nil pointer receiver")
+	}
+	if _M4.Idle(&_M4Policy.Validate) {
+		return _recv.validate(s)
+	}
+	_fn, _ok := mock.Next(_M4Entry(_recv), _M4.Global(), (*_M4Data)._Validate, &_M4Policy.Validate, _M4_Validate_Call{s})
+	if !_ok {
+		_fn = _recv.validate
+	}
+	return _fn(s)
+}
+
+func (_recv *M4) _Validate_Do(fn func(string) error) {
+	mock.Do(_M4Instance(_recv, "M4.Validate"), (*_M4Data)._Validate, fn)
+}
+
+func (_recv *M4) _Validate_DoT(t testing.TB, fn func(string) error) {
+	mock.DoT(t, _M4Instance(_recv, "M4.Validate"), (*_M4Data)._Validate, fn)
+}
+
+func (M4) _Validate_DoAll(t testing.TB, fn func(string) error) {
+	t.Helper()
+	mock.Serial(t, "M4.Validate")
+	mock.DoAll(t, _M4.Global(), (*_M4Data)._Validate, fn)
+}
+
+func (_recv *M4) _Validate_Stub() {
+	_recv._Validate_Do(func(string) (r0 error) { return })
+}
+
+func (_recv *M4) _Validate_StubT(t testing.TB) {
+	_recv._Validate_DoT(t, func(string) (r0 error) { return })
+}
+
+func (M4) _Validate_StubAll(t testing.TB) {
+	t.Helper()
+	new(M4)._Validate_DoAll(t, func(string) (r0 error) { return })
+}
+
+func (_recv *M4) _Validate_Return(r0 error) {
+	_recv._Validate_Do(func(string) error { return r0 })
+}
+
+func (_recv *M4) _Validate_ReturnT(t testing.TB, r0 error) {
+	_recv._Validate_DoT(t, func(string) error { return r0 })
+}
+
+func (M4) _Validate_ReturnAll(t testing.TB, r0 error) {
+	t.Helper()
+	new(M4)._Validate_DoAll(t, func(string) error { return r0 })
+}
+
+func (_recv *M4) _Validate_Calls() []_M4_Validate_Call {
+	return mock.Calls(_M4Instance(_recv, "M4.Validate"), (*_M4Data)._Validate)
+}
+
+func (M4) _Validate_AllCalls() []_M4_Validate_Call {
+	return mock.Calls(_M4.Global(), (*_M4Data)._Validate)
+}
+
+func (M4) _Validate_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M4.Validate")
+	mock.Bubble(t, _M4.Global(), func(_dat *_M4Data) { _dat.Validate.Calls.Clear() }, &_M4Policy.Validate)
+}
+
+func (M4) _Validate_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M4.Validate")
+	_M4Policy.Validate.Record(t, limit)
+}
@@ -0,0 +1,153 @@
+// Code generated by lesiw.io/moxie. DO NOT EDIT.
+
+//go:build tagged
+
+package testdata
+
+import (
+	"testing"
+
+	"lesiw.io/moxie/mock"
+)
+
+var (
+	_M5       mock.Registry[M5, _M5Data]
+	_M5Policy _M5Policies
+)
+
+type _M5Data struct {
+	Read mock.Method[func([]byte) (int, error), _M5_Read_Call]
+}
+
+type _M5Policies struct {
+	Read mock.Policy
+}
+
+func _M5Entry(t *M5) *mock.Entry[_M5Data] {
+	return _M5.Get(t)
+}
+
+func _M5Instance(t *M5, name string) *mock.Entry[_M5Data] {
+	if t == nil {
+		panic(name + ": nil pointer receiver")
+	}
+	return _M5Entry(t)
+}
+
+func (_recv *M5) _M5_Reset() {
+	_M5Instance(_recv, "M5").Reset()
+}
+
+func (M5) _M5_ResetAll() {
+	_M5.Global().Reset()
+}
+
+func (M5) _M5_BubbleAll(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M5")
+	mock.Bubble(t, _M5.Global(), (*_M5Data).clearCalls, _M5Policy.all()...)
+}
+
+func (M5) _M5_RecordAll(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M5")
+	for _, p := range _M5Policy.all() {
+		p.Record(t, limit)
+	}
+}
+
+func (_dat *_M5Data) clearCalls() {
+	_dat.Read.Calls.Clear()
+}
+
+func (_p *_M5Policies) all() []*mock.Policy {
+	return []*mock.Policy{
+		&_p.Read,
+	}
+}
+
+type _M5_Read_Call struct {
+	P []byte
+}
+
+func (_dat *_M5Data) _Read() *mock.Method[func([]byte) (int, error), _M5_Read_Call] {
+	return &_dat.Read
+}
+
+func (_recv *M5) Read(p []byte) (int, error) {
+	if _recv == nil {
+		panic("M5.Read: nil pointer receiver")
+	}
+	if _M5.Idle(&_M5Policy.Read) &&
+		_recv.Reader != nil {
+		return _recv.Reader.Read(p)
+	}
+	_fn, _ok := mock.Next(_M5Entry(_recv), _M5.Global(), (*_M5Data)._Read, &_M5Policy.Read, _M5_Read_Call{p})
+	if !_ok {
+		if _recv.Reader == nil {
+			panic("M5.Read: not mocked and embedded io.Reader is nil")
+		}
+		_fn = _recv.Reader.Read
+	}
+	return _fn(p)
+}
+
+func (_recv *M5) _Read_Do(fn func([]byte) (int, error)) {
+	mock.Do(_M5Instance(_recv, "M5.Read"), (*_M5Data)._Read, fn)
+}
+
+func (_recv *M5) _Read_DoT(t testing.TB, fn func([]byte) (int, error)) {
+	mock.DoT(t, _M5Instance(_recv, "M5.Read"), (*_M5Data)._Read, fn)
+}
+
+func (M5) _Read_DoAll(t testing.TB, fn func([]byte) (int, error)) {
+	t.Helper()
+	mock.Serial(t, "M5.Read")
+	mock.DoAll(t, _M5.Global(), (*_M5Data)._Read, fn)
+}
+
+func (_recv *M5) _Read_Stub() {
+	_recv._Read_Do(func([]byte) (n int, err error) { return })
+}
+
+func (_recv *M5) _Read_StubT(t testing.TB) {
+	_recv._Read_DoT(t, func([]byte) (n int, err error) { return })
+}
+
+func (M5) _Read_StubAll(t testing.TB) {
+	t.Helper()
+	new(M5)._Read_DoAll(t, func([]byte) (n int, err error) { return })
+}
+
+func (_recv *M5) _Read_Return(n int, err error) {
+	_recv._Read_Do(func([]byte) (int, error) { return n, err })
+}
+
+func (_recv *M5) _Read_ReturnT(t testing.TB, n int, err error) {
+	_recv._Read_DoT(t, func([]byte) (int, error) { return n, err })
+}
+
+func (M5) _Read_ReturnAll(t testing.TB, n int, err error) {
+	t.Helper()
+	new(M5)._Read_DoAll(t, func([]byte) (int, error) { return n, err })
+}
+
+func (_recv *M5) _Read_Calls() []_M5_Read_Call {
+	return mock.Calls(_M5Instance(_recv, "M5.Read"), (*_M5Data)._Read)
+}
+
+func (M5) _Read_AllCalls() []_M5_Read_Call {
+	return mock.Calls(_M5.Global(), (*_M5Data)._Read)
+}
+
+func (M5) _Read_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M5.Read")
+	mock.Bubble(t, _M5.Global(), func(_dat *_M5Data) { _dat.Read.Calls.Clear() }, &_M5Policy.Read)
+}
+
+func (M5) _Read_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M5.Read")
+	_M5Policy.Read.Record(t, limit)
+}
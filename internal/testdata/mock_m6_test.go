@@ -0,0 +1,494 @@
+// Code generated by lesiw.io/moxie. DO NOT EDIT.
+
+package testdata
+
+import (
+	"testing"
+	"time"
+
+	"lesiw.io/moxie/mock"
+
+	"lesiw.io/moxie/internal/testdata/pkg"
+)
+
+var (
+	_M6       mock.Registry[M6, _M6Data]
+	_M6Policy _M6Policies
+)
+
+type _M6Data struct {
+	Each   mock.Method[func(func(key pkg.String, value *pkg.User) bool), _M6_Each_Call]
+	Expire mock.Method[func(pkg.String, time.Duration) map[pkg.String]*pkg.User, _M6_Expire_Call]
+	Get    mock.Method[func(pkg.String) (*pkg.User, bool), _M6_Get_Call]
+	Keys   mock.Method[func(...func(pkg.String) bool) []pkg.String, _M6_Keys_Call]
+	Put    mock.Method[func(pkg.String, *pkg.User), _M6_Put_Call]
+}
+
+type _M6Policies struct {
+	Each   mock.Policy
+	Expire mock.Policy
+	Get    mock.Policy
+	Keys   mock.Policy
+	Put    mock.Policy
+}
+
+func _M6Entry(t *M6) *mock.Entry[_M6Data] {
+	return _M6.Get(t)
+}
+
+func _M6Instance(t *M6, name string) *mock.Entry[_M6Data] {
+	if t == nil {
+		panic(name + ": nil pointer receiver")
+	}
+	return _M6Entry(t)
+}
+
+func (_recv *M6) _M6_Reset() {
+	_M6Instance(_recv, "M6").Reset()
+}
+
+func (M6) _M6_ResetAll() {
+	_M6.Global().Reset()
+}
+
+func (M6) _M6_BubbleAll(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M6")
+	mock.Bubble(t, _M6.Global(), (*_M6Data).clearCalls, _M6Policy.all()...)
+}
+
+func (M6) _M6_RecordAll(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M6")
+	for _, p := range _M6Policy.all() {
+		p.Record(t, limit)
+	}
+}
+
+func (_dat *_M6Data) clearCalls() {
+	_dat.Each.Calls.Clear()
+	_dat.Expire.Calls.Clear()
+	_dat.Get.Calls.Clear()
+	_dat.Keys.Calls.Clear()
+	_dat.Put.Calls.Clear()
+}
+
+func (_p *_M6Policies) all() []*mock.Policy {
+	return []*mock.Policy{
+		&_p.Each,
+		&_p.Expire,
+		&_p.Get,
+		&_p.Keys,
+		&_p.Put,
+	}
+}
+
+type _M6_Each_Call struct {
+	Fn func(key pkg.String, value *pkg.User) bool
+}
+type _M6_Expire_Call struct {
+	Key  pkg.String
+	Time time.Duration
+}
+type _M6_Get_Call struct {
+	Key pkg.String
+}
+type _M6_Keys_Call struct {
+	Filters []func(pkg.String) bool
+}
+type _M6_Put_Call struct {
+	Key   pkg.String
+	Value *pkg.User
+}
+
+func (_dat *_M6Data) _Each() *mock.Method[func(func(key pkg.String, value *pkg.User) bool), _M6_Each_Call] {
+	return &_dat.Each
+}
+
+func (_recv *M6) Each(fn func(key pkg.String, value *pkg.User) bool) {
+	if _recv == nil {
+		panic("M6.Each: nil pointer receiver")
+	}
+	if _M6.Idle(&_M6Policy.Each) {
+		_recv.Store.Each(fn)
+		return
+	}
+	_fn, _ok := mock.Next(_M6Entry(_recv), _M6.Global(), (*_M6Data)._Each, &_M6Policy.Each, _M6_Each_Call{fn})
+	if !_ok {
+		_fn = _recv.Store.Each
+	}
+	_fn(fn)
+}
+
+func (_recv *M6) _Each_Do(fn func(func(key pkg.String, value *pkg.User) bool)) {
+	mock.Do(_M6Instance(_recv, "M6.Each"), (*_M6Data)._Each, fn)
+}
+
+func (_recv *M6) _Each_DoT(t testing.TB, fn func(func(key pkg.String, value *pkg.User) bool)) {
+	mock.DoT(t, _M6Instance(_recv, "M6.Each"), (*_M6Data)._Each, fn)
+}
+
+func (M6) _Each_DoAll(t testing.TB, fn func(func(key pkg.String, value *pkg.User) bool)) {
+	t.Helper()
+	mock.Serial(t, "M6.Each")
+	mock.DoAll(t, _M6.Global(), (*_M6Data)._Each, fn)
+}
+
+func (_recv *M6) _Each_Stub() {
+	_recv._Each_Do(func(func(key pkg.String, value *pkg.User) bool) { return })
+}
+
+func (_recv *M6) _Each_StubT(t testing.TB) {
+	_recv._Each_DoT(t, func(func(key pkg.String, value *pkg.User) bool) { return })
+}
+
+func (M6) _Each_StubAll(t testing.TB) {
+	t.Helper()
+	new(M6)._Each_DoAll(t, func(func(key pkg.String, value *pkg.User) bool) { return })
+}
+
+func (_recv *M6) _Each_Return() {
+	_recv._Each_Do(func(func(key pkg.String, value *pkg.User) bool) { return })
+}
+
+func (_recv *M6) _Each_ReturnT(t testing.TB) {
+	_recv._Each_DoT(t, func(func(key pkg.String, value *pkg.User) bool) { return })
+}
+
+func (M6) _Each_ReturnAll(t testing.TB) {
+	t.Helper()
+	new(M6)._Each_DoAll(t, func(func(key pkg.String, value *pkg.User) bool) { return })
+}
+
+func (_recv *M6) _Each_Calls() []_M6_Each_Call {
+	return mock.Calls(_M6Instance(_recv, "M6.Each"), (*_M6Data)._Each)
+}
+
+func (M6) _Each_AllCalls() []_M6_Each_Call {
+	return mock.Calls(_M6.Global(), (*_M6Data)._Each)
+}
+
+func (M6) _Each_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M6.Each")
+	mock.Bubble(t, _M6.Global(), func(_dat *_M6Data) { _dat.Each.Calls.Clear() }, &_M6Policy.Each)
+}
+
+func (M6) _Each_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M6.Each")
+	_M6Policy.Each.Record(t, limit)
+}
+
+func (_dat *_M6Data) _Expire() *mock.Method[func(pkg.String, time.Duration) map[pkg.String]*pkg.User, _M6_Expire_Call] {
+	return &_dat.Expire
+}
+
+func (_recv *M6) Expire(key pkg.String, time_ time.Duration) map[pkg.String]*pkg.User {
+	if _recv == nil {
+		panic("M6.Expire: nil pointer receiver")
+	}
+	if _M6.Idle(&_M6Policy.Expire) {
+		return _recv.Store.Expire(key, time_)
+	}
+	_fn, _ok := mock.Next(_M6Entry(_recv), _M6.Global(), (*_M6Data)._Expire, &_M6Policy.Expire, _M6_Expire_Call{key, time_})
+	if !_ok {
+		_fn = _recv.Store.Expire
+	}
+	return _fn(key, time_)
+}
+
+func (_recv *M6) _Expire_Do(fn func(pkg.String, time.Duration) map[pkg.String]*pkg.User) {
+	mock.Do(_M6Instance(_recv, "M6.Expire"), (*_M6Data)._Expire, fn)
+}
+
+func (_recv *M6) _Expire_DoT(t testing.TB, fn func(pkg.String, time.Duration) map[pkg.String]*pkg.User) {
+	mock.DoT(t, _M6Instance(_recv, "M6.Expire"), (*_M6Data)._Expire, fn)
+}
+
+func (M6) _Expire_DoAll(t testing.TB, fn func(pkg.String, time.Duration) map[pkg.String]*pkg.User) {
+	t.Helper()
+	mock.Serial(t, "M6.Expire")
+	mock.DoAll(t, _M6.Global(), (*_M6Data)._Expire, fn)
+}
+
+func (_recv *M6) _Expire_Stub() {
+	_recv._Expire_Do(func(pkg.String, time.Duration) (r0 map[pkg.String]*pkg.User) { return })
+}
+
+func (_recv *M6) _Expire_StubT(t testing.TB) {
+	_recv._Expire_DoT(t, func(pkg.String, time.Duration) (r0 map[pkg.String]*pkg.User) { return })
+}
+
+func (M6) _Expire_StubAll(t testing.TB) {
+	t.Helper()
+	new(M6)._Expire_DoAll(t, func(pkg.String, time.Duration) (r0 map[pkg.String]*pkg.User) { return })
+}
+
+func (_recv *M6) _Expire_Return(r0 map[pkg.String]*pkg.User) {
+	_recv._Expire_Do(func(pkg.String, time.Duration) map[pkg.String]*pkg.User { return r0 })
+}
+
+func (_recv *M6) _Expire_ReturnT(t testing.TB, r0 map[pkg.String]*pkg.User) {
+	_recv._Expire_DoT(t, func(pkg.String, time.Duration) map[pkg.String]*pkg.User { return r0 })
+}
+
+func (M6) _Expire_ReturnAll(t testing.TB, r0 map[pkg.String]*pkg.User) {
+	t.Helper()
+	new(M6)._Expire_DoAll(t, func(pkg.String, time.Duration) map[pkg.String]*pkg.User { return r0 })
+}
+
+func (_recv *M6) _Expire_Calls() []_M6_Expire_Call {
+	return mock.Calls(_M6Instance(_recv, "M6.Expire"), (*_M6Data)._Expire)
+}
+
+func (M6) _Expire_AllCalls() []_M6_Expire_Call {
+	return mock.Calls(_M6.Global(), (*_M6Data)._Expire)
+}
+
+func (M6) _Expire_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M6.Expire")
+	mock.Bubble(t, _M6.Global(), func(_dat *_M6Data) { _dat.Expire.Calls.Clear() }, &_M6Policy.Expire)
+}
+
+func (M6) _Expire_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M6.Expire")
+	_M6Policy.Expire.Record(t, limit)
+}
+
+func (_dat *_M6Data) _Get() *mock.Method[func(pkg.String) (*pkg.User, bool), _M6_Get_Call] {
+	return &_dat.Get
+}
+
+func (_recv *M6) Get(key pkg.String) (*pkg.User, bool) {
+	if _recv == nil {
+		panic("M6.Get: nil pointer receiver")
+	}
+	if _M6.Idle(&_M6Policy.Get) {
+		return _recv.Store.Get(key)
+	}
+	_fn, _ok := mock.Next(_M6Entry(_recv), _M6.Global(), (*_M6Data)._Get, &_M6Policy.Get, _M6_Get_Call{key})
+	if !_ok {
+		_fn = _recv.Store.Get
+	}
+	return _fn(key)
+}
+
+func (_recv *M6) _Get_Do(fn func(pkg.String) (*pkg.User, bool)) {
+	mock.Do(_M6Instance(_recv, "M6.Get"), (*_M6Data)._Get, fn)
+}
+
+func (_recv *M6) _Get_DoT(t testing.TB, fn func(pkg.String) (*pkg.User, bool)) {
+	mock.DoT(t, _M6Instance(_recv, "M6.Get"), (*_M6Data)._Get, fn)
+}
+
+func (M6) _Get_DoAll(t testing.TB, fn func(pkg.String) (*pkg.User, bool)) {
+	t.Helper()
+	mock.Serial(t, "M6.Get")
+	mock.DoAll(t, _M6.Global(), (*_M6Data)._Get, fn)
+}
+
+func (_recv *M6) _Get_Stub() {
+	_recv._Get_Do(func(pkg.String) (r0 *pkg.User, r1 bool) { return })
+}
+
+func (_recv *M6) _Get_StubT(t testing.TB) {
+	_recv._Get_DoT(t, func(pkg.String) (r0 *pkg.User, r1 bool) { return })
+}
+
+func (M6) _Get_StubAll(t testing.TB) {
+	t.Helper()
+	new(M6)._Get_DoAll(t, func(pkg.String) (r0 *pkg.User, r1 bool) { return })
+}
+
+func (_recv *M6) _Get_Return(r0 *pkg.User, r1 bool) {
+	_recv._Get_Do(func(pkg.String) (*pkg.User, bool) { return r0, r1 })
+}
+
+func (_recv *M6) _Get_ReturnT(t testing.TB, r0 *pkg.User, r1 bool) {
+	_recv._Get_DoT(t, func(pkg.String) (*pkg.User, bool) { return r0, r1 })
+}
+
+func (M6) _Get_ReturnAll(t testing.TB, r0 *pkg.User, r1 bool) {
+	t.Helper()
+	new(M6)._Get_DoAll(t, func(pkg.String) (*pkg.User, bool) { return r0, r1 })
+}
+
+func (_recv *M6) _Get_Calls() []_M6_Get_Call {
+	return mock.Calls(_M6Instance(_recv, "M6.Get"), (*_M6Data)._Get)
+}
+
+func (M6) _Get_AllCalls() []_M6_Get_Call {
+	return mock.Calls(_M6.Global(), (*_M6Data)._Get)
+}
+
+func (M6) _Get_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M6.Get")
+	mock.Bubble(t, _M6.Global(), func(_dat *_M6Data) { _dat.Get.Calls.Clear() }, &_M6Policy.Get)
+}
+
+func (M6) _Get_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M6.Get")
+	_M6Policy.Get.Record(t, limit)
+}
+
+func (_dat *_M6Data) _Keys() *mock.Method[func(...func(pkg.String) bool) []pkg.String, _M6_Keys_Call] {
+	return &_dat.Keys
+}
+
+func (_recv *M6) Keys(filters ...func(pkg.String) bool) []pkg.String {
+	if _recv == nil {
+		panic("M6.Keys: nil pointer receiver")
+	}
+	if _M6.Idle(&_M6Policy.Keys) {
+		return _recv.Store.Keys(filters...)
+	}
+	_fn, _ok := mock.Next(_M6Entry(_recv), _M6.Global(), (*_M6Data)._Keys, &_M6Policy.Keys, _M6_Keys_Call{filters})
+	if !_ok {
+		_fn = _recv.Store.Keys
+	}
+	return _fn(filters...)
+}
+
+func (_recv *M6) _Keys_Do(fn func(...func(pkg.String) bool) []pkg.String) {
+	mock.Do(_M6Instance(_recv, "M6.Keys"), (*_M6Data)._Keys, fn)
+}
+
+func (_recv *M6) _Keys_DoT(t testing.TB, fn func(...func(pkg.String) bool) []pkg.String) {
+	mock.DoT(t, _M6Instance(_recv, "M6.Keys"), (*_M6Data)._Keys, fn)
+}
+
+func (M6) _Keys_DoAll(t testing.TB, fn func(...func(pkg.String) bool) []pkg.String) {
+	t.Helper()
+	mock.Serial(t, "M6.Keys")
+	mock.DoAll(t, _M6.Global(), (*_M6Data)._Keys, fn)
+}
+
+func (_recv *M6) _Keys_Stub() {
+	_recv._Keys_Do(func(...func(pkg.String) bool) (r0 []pkg.String) { return })
+}
+
+func (_recv *M6) _Keys_StubT(t testing.TB) {
+	_recv._Keys_DoT(t, func(...func(pkg.String) bool) (r0 []pkg.String) { return })
+}
+
+func (M6) _Keys_StubAll(t testing.TB) {
+	t.Helper()
+	new(M6)._Keys_DoAll(t, func(...func(pkg.String) bool) (r0 []pkg.String) { return })
+}
+
+func (_recv *M6) _Keys_Return(r0 []pkg.String) {
+	_recv._Keys_Do(func(...func(pkg.String) bool) []pkg.String { return r0 })
+}
+
+func (_recv *M6) _Keys_ReturnT(t testing.TB, r0 []pkg.String) {
+	_recv._Keys_DoT(t, func(...func(pkg.String) bool) []pkg.String { return r0 })
+}
+
+func (M6) _Keys_ReturnAll(t testing.TB, r0 []pkg.String) {
+	t.Helper()
+	new(M6)._Keys_DoAll(t, func(...func(pkg.String) bool) []pkg.String { return r0 })
+}
+
+func (_recv *M6) _Keys_Calls() []_M6_Keys_Call {
+	return mock.Calls(_M6Instance(_recv, "M6.Keys"), (*_M6Data)._Keys)
+}
+
+func (M6) _Keys_AllCalls() []_M6_Keys_Call {
+	return mock.Calls(_M6.Global(), (*_M6Data)._Keys)
+}
+
+func (M6) _Keys_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M6.Keys")
+	mock.Bubble(t, _M6.Global(), func(_dat *_M6Data) { _dat.Keys.Calls.Clear() }, &_M6Policy.Keys)
+}
+
+func (M6) _Keys_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M6.Keys")
+	_M6Policy.Keys.Record(t, limit)
+}
+
+func (_dat *_M6Data) _Put() *mock.Method[func(pkg.String, *pkg.User), _M6_Put_Call] {
+	return &_dat.Put
+}
+
+func (_recv *M6) Put(key pkg.String, value *pkg.User) {
+	if _recv == nil {
+		panic("M6.Put: nil pointer receiver")
+	}
+	if _M6.Idle(&_M6Policy.Put) {
+		_recv.Store.Put(key, value)
+		return
+	}
+	_fn, _ok := mock.Next(_M6Entry(_recv), _M6.Global(), (*_M6Data)._Put, &_M6Policy.Put, _M6_Put_Call{key, value})
+	if !_ok {
+		_fn = _recv.Store.Put
+	}
+	_fn(key, value)
+}
+
+func (_recv *M6) _Put_Do(fn func(pkg.String, *pkg.User)) {
+	mock.Do(_M6Instance(_recv, "M6.Put"), (*_M6Data)._Put, fn)
+}
+
+func (_recv *M6) _Put_DoT(t testing.TB, fn func(pkg.String, *pkg.User)) {
+	mock.DoT(t, _M6Instance(_recv, "M6.Put"), (*_M6Data)._Put, fn)
+}
+
+func (M6) _Put_DoAll(t testing.TB, fn func(pkg.String, *pkg.User)) {
+	t.Helper()
+	mock.Serial(t, "M6.Put")
+	mock.DoAll(t, _M6.Global(), (*_M6Data)._Put, fn)
+}
+
+func (_recv *M6) _Put_Stub() {
+	_recv._Put_Do(func(pkg.String, *pkg.User) { return })
+}
+
+func (_recv *M6) _Put_StubT(t testing.TB) {
+	_recv._Put_DoT(t, func(pkg.String, *pkg.User) { return })
+}
+
+func (M6) _Put_StubAll(t testing.TB) {
+	t.Helper()
+	new(M6)._Put_DoAll(t, func(pkg.String, *pkg.User) { return })
+}
+
+func (_recv *M6) _Put_Return() {
+	_recv._Put_Do(func(pkg.String, *pkg.User) { return })
+}
+
+func (_recv *M6) _Put_ReturnT(t testing.TB) {
+	_recv._Put_DoT(t, func(pkg.String, *pkg.User) { return })
+}
+
+func (M6) _Put_ReturnAll(t testing.TB) {
+	t.Helper()
+	new(M6)._Put_DoAll(t, func(pkg.String, *pkg.User) { return })
+}
+
+func (_recv *M6) _Put_Calls() []_M6_Put_Call {
+	return mock.Calls(_M6Instance(_recv, "M6.Put"), (*_M6Data)._Put)
+}
+
+func (M6) _Put_AllCalls() []_M6_Put_Call {
+	return mock.Calls(_M6.Global(), (*_M6Data)._Put)
+}
+
+func (M6) _Put_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M6.Put")
+	mock.Bubble(t, _M6.Global(), func(_dat *_M6Data) { _dat.Put.Calls.Clear() }, &_M6Policy.Put)
+}
+
+func (M6) _Put_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M6.Put")
+	_M6Policy.Put.Record(t, limit)
+}
@@ -0,0 +1,148 @@
+// Code generated by lesiw.io/moxie. DO NOT EDIT.
+
+package testdata
+
+import (
+	"testing"
+
+	"lesiw.io/moxie/mock"
+)
+
+var (
+	_M8       mock.Registry[M8, _M8Data]
+	_M8Policy _M8Policies
+)
+
+type _M8Data struct {
+	Log mock.Method[func(string), _M8_Log_Call]
+}
+
+type _M8Policies struct {
+	Log mock.Policy
+}
+
+func _M8Entry(t *M8) *mock.Entry[_M8Data] {
+	return _M8.Get(t)
+}
+
+func _M8Instance(t *M8, name string) *mock.Entry[_M8Data] {
+	if t == nil {
+		panic(name + ": nil pointer receiver")
+	}
+	return _M8Entry(t)
+}
+
+func (_recv *M8) _M8_Reset() {
+	_M8Instance(_recv, "M8").Reset()
+}
+
+func (M8) _M8_ResetAll() {
+	_M8.Global().Reset()
+}
+
+func (M8) _M8_BubbleAll(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M8")
+	mock.Bubble(t, _M8.Global(), (*_M8Data).clearCalls, _M8Policy.all()...)
+}
+
+func (M8) _M8_RecordAll(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M8")
+	for _, p := range _M8Policy.all() {
+		p.Record(t, limit)
+	}
+}
+
+func (_dat *_M8Data) clearCalls() {
+	_dat.Log.Calls.Clear()
+}
+
+func (_p *_M8Policies) all() []*mock.Policy {
+	return []*mock.Policy{
+		&_p.Log,
+	}
+}
+
+type _M8_Log_Call struct {
+	Line string
+}
+
+func (_dat *_M8Data) _Log() *mock.Method[func(string), _M8_Log_Call] {
+	return &_dat.Log
+}
+
+func (_recv *M8) Log(line string) {
+	if _recv == nil {
+		panic("M8.Log: nil pointer receiver")
+	}
+	if _M8.Idle(&_M8Policy.Log) {
+		_recv.Logger.Log(line)
+		return
+	}
+	_fn, _ok := mock.Next(_M8Entry(_recv), _M8.Global(), (*_M8Data)._Log, &_M8Policy.Log, _M8_Log_Call{line})
+	if !_ok {
+		_fn = _recv.Logger.Log
+	}
+	_fn(line)
+}
+
+func (_recv *M8) _Log_Do(fn func(string)) {
+	mock.Do(_M8Instance(_recv, "M8.Log"), (*_M8Data)._Log, fn)
+}
+
+func (_recv *M8) _Log_DoT(t testing.TB, fn func(string)) {
+	mock.DoT(t, _M8Instance(_recv, "M8.Log"), (*_M8Data)._Log, fn)
+}
+
+func (M8) _Log_DoAll(t testing.TB, fn func(string)) {
+	t.Helper()
+	mock.Serial(t, "M8.Log")
+	mock.DoAll(t, _M8.Global(), (*_M8Data)._Log, fn)
+}
+
+func (_recv *M8) _Log_Stub() {
+	_recv._Log_Do(func(string) { return })
+}
+
+func (_recv *M8) _Log_StubT(t testing.TB) {
+	_recv._Log_DoT(t, func(string) { return })
+}
+
+func (M8) _Log_StubAll(t testing.TB) {
+	t.Helper()
+	new(M8)._Log_DoAll(t, func(string) { return })
+}
+
+func (_recv *M8) _Log_Return() {
+	_recv._Log_Do(func(string) { return })
+}
+
+func (_recv *M8) _Log_ReturnT(t testing.TB) {
+	_recv._Log_DoT(t, func(string) { return })
+}
+
+func (M8) _Log_ReturnAll(t testing.TB) {
+	t.Helper()
+	new(M8)._Log_DoAll(t, func(string) { return })
+}
+
+func (_recv *M8) _Log_Calls() []_M8_Log_Call {
+	return mock.Calls(_M8Instance(_recv, "M8.Log"), (*_M8Data)._Log)
+}
+
+func (M8) _Log_AllCalls() []_M8_Log_Call {
+	return mock.Calls(_M8.Global(), (*_M8Data)._Log)
+}
+
+func (M8) _Log_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "M8.Log")
+	mock.Bubble(t, _M8.Global(), func(_dat *_M8Data) { _dat.Log.Calls.Clear() }, &_M8Policy.Log)
+}
+
+func (M8) _Log_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "M8.Log")
+	_M8Policy.Log.Record(t, limit)
+}
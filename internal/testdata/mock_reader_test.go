@@ -0,0 +1,157 @@
+// Code generated by lesiw.io/moxie. DO NOT EDIT.
+
+package testdata
+
+import (
+	"io"
+	"testing"
+
+	"lesiw.io/moxie/mock"
+)
+
+// FakeReader embeds io.ReadWriter so that its methods can be mocked.
+type FakeReader struct {
+	io.ReadWriter
+}
+
+var (
+	_FakeReader       mock.Registry[FakeReader, _FakeReaderData]
+	_FakeReaderPolicy _FakeReaderPolicies
+)
+
+type _FakeReaderData struct {
+	Read mock.Method[func([]byte) (int, error), _FakeReader_Read_Call]
+}
+
+type _FakeReaderPolicies struct {
+	Read mock.Policy
+}
+
+func _FakeReaderEntry(t *FakeReader) *mock.Entry[_FakeReaderData] {
+	return _FakeReader.Get(t)
+}
+
+func _FakeReaderInstance(t *FakeReader, name string) *mock.Entry[_FakeReaderData] {
+	if t == nil {
+		panic(name + ": nil pointer receiver")
+	}
+	return _FakeReaderEntry(t)
+}
+
+func (_recv *FakeReader) _FakeReader_Reset() {
+	_FakeReaderInstance(_recv, "FakeReader").Reset()
+}
+
+func (FakeReader) _FakeReader_ResetAll() {
+	_FakeReader.Global().Reset()
+}
+
+func (FakeReader) _FakeReader_BubbleAll(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "FakeReader")
+	mock.Bubble(t, _FakeReader.Global(), (*_FakeReaderData).clearCalls, _FakeReaderPolicy.all()...)
+}
+
+func (FakeReader) _FakeReader_RecordAll(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "FakeReader")
+	for _, p := range _FakeReaderPolicy.all() {
+		p.Record(t, limit)
+	}
+}
+
+func (_dat *_FakeReaderData) clearCalls() {
+	_dat.Read.Calls.Clear()
+}
+
+func (_p *_FakeReaderPolicies) all() []*mock.Policy {
+	return []*mock.Policy{
+		&_p.Read,
+	}
+}
+
+type _FakeReader_Read_Call struct {
+	P []byte
+}
+
+func (_dat *_FakeReaderData) _Read() *mock.Method[func([]byte) (int, error), _FakeReader_Read_Call] {
+	return &_dat.Read
+}
+
+func (_recv *FakeReader) Read(p []byte) (int, error) {
+	if _recv == nil {
+		panic("FakeReader.Read: nil pointer receiver")
+	}
+	if _FakeReader.Idle(&_FakeReaderPolicy.Read) &&
+		_recv.ReadWriter != nil {
+		return _recv.ReadWriter.Read(p)
+	}
+	_fn, _ok := mock.Next(_FakeReaderEntry(_recv), _FakeReader.Global(), (*_FakeReaderData)._Read, &_FakeReaderPolicy.Read, _FakeReader_Read_Call{p})
+	if !_ok {
+		if _recv.ReadWriter == nil {
+			panic("FakeReader.Read: not mocked and embedded io.ReadWriter is nil")
+		}
+		_fn = _recv.ReadWriter.Read
+	}
+	return _fn(p)
+}
+
+func (_recv *FakeReader) _Read_Do(fn func([]byte) (int, error)) {
+	mock.Do(_FakeReaderInstance(_recv, "FakeReader.Read"), (*_FakeReaderData)._Read, fn)
+}
+
+func (_recv *FakeReader) _Read_DoT(t testing.TB, fn func([]byte) (int, error)) {
+	mock.DoT(t, _FakeReaderInstance(_recv, "FakeReader.Read"), (*_FakeReaderData)._Read, fn)
+}
+
+func (FakeReader) _Read_DoAll(t testing.TB, fn func([]byte) (int, error)) {
+	t.Helper()
+	mock.Serial(t, "FakeReader.Read")
+	mock.DoAll(t, _FakeReader.Global(), (*_FakeReaderData)._Read, fn)
+}
+
+func (_recv *FakeReader) _Read_Stub() {
+	_recv._Read_Do(func([]byte) (n int, err error) { return })
+}
+
+func (_recv *FakeReader) _Read_StubT(t testing.TB) {
+	_recv._Read_DoT(t, func([]byte) (n int, err error) { return })
+}
+
+func (FakeReader) _Read_StubAll(t testing.TB) {
+	t.Helper()
+	new(FakeReader)._Read_DoAll(t, func([]byte) (n int, err error) { return })
+}
+
+func (_recv *FakeReader) _Read_Return(n int, err error) {
+	_recv._Read_Do(func([]byte) (int, error) { return n, err })
+}
+
+func (_recv *FakeReader) _Read_ReturnT(t testing.TB, n int, err error) {
+	_recv._Read_DoT(t, func([]byte) (int, error) { return n, err })
+}
+
+func (FakeReader) _Read_ReturnAll(t testing.TB, n int, err error) {
+	t.Helper()
+	new(FakeReader)._Read_DoAll(t, func([]byte) (int, error) { return n, err })
+}
+
+func (_recv *FakeReader) _Read_Calls() []_FakeReader_Read_Call {
+	return mock.Calls(_FakeReaderInstance(_recv, "FakeReader.Read"), (*_FakeReaderData)._Read)
+}
+
+func (FakeReader) _Read_AllCalls() []_FakeReader_Read_Call {
+	return mock.Calls(_FakeReader.Global(), (*_FakeReaderData)._Read)
+}
+
+func (FakeReader) _Read_BubbleCalls(t testing.TB) {
+	t.Helper()
+	mock.Serial(t, "FakeReader.Read")
+	mock.Bubble(t, _FakeReader.Global(), func(_dat *_FakeReaderData) { _dat.Read.Calls.Clear() }, &_FakeReaderPolicy.Read)
+}
+
+func (FakeReader) _Read_Record(t testing.TB, limit int) {
+	t.Helper()
+	mock.Serial(t, "FakeReader.Read")
+	_FakeReaderPolicy.Read.Record(t, limit)
+}
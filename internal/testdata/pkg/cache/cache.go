@@ -0,0 +1,47 @@
+// Package cache has a generic type to embed with type arguments from other
+// packages.
+package cache
+
+import "time"
+
+type Store[K comparable, V any] struct {
+	m map[K]V
+}
+
+func (s *Store[K, V]) Get(key K) (V, bool) {
+	v, ok := s.m[key]
+	return v, ok
+}
+
+func (s *Store[K, V]) Put(key K, value V) {
+	if s.m == nil {
+		s.m = make(map[K]V)
+	}
+	s.m[key] = value
+}
+
+func (s *Store[K, V]) Each(fn func(key K, value V) bool) {
+	for k, v := range s.m {
+		if !fn(k, v) {
+			return
+		}
+	}
+}
+
+func (s *Store[K, V]) Keys(filters ...func(K) bool) []K {
+	var keys []K
+next:
+	for k := range s.m {
+		for _, filter := range filters {
+			if !filter(k) {
+				continue next
+			}
+		}
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func (s *Store[K, V]) Expire(key K, time time.Duration) map[K]V {
+	return nil
+}
@@ -0,0 +1,4 @@
+// Package hidden can only be imported from within pkg.
+package hidden
+
+type T struct{ _ bool }
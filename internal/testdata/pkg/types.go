@@ -1,6 +1,11 @@
 package pkg
 
-import "io"
+import (
+	"context"
+	"io"
+
+	"lesiw.io/moxie/internal/testdata/pkg/internal/hidden"
+)
 
 // The type under test cannot be the empty struct.
 // Empty structs are subject to special optimization and may return the same
@@ -55,3 +60,29 @@ func (T0) NamedMixedTwoResults(x String, y ...String) (Int, error) {
 func (T0) AllNamedIdentifiers(x String, y ...String) (n Int, err error) {
 	return
 }
+
+func (T0) ContextNoResult(context.Context) {}
+
+func (T0) ContextOneResult(ctx context.Context, x String) error {
+	return nil
+}
+
+func (T0) ContextTwoResults(ctx context.Context) (Int, error) {
+	return 0, nil
+}
+
+type T1 struct{ _ bool }
+
+func (T1) Value() Int { return 1 }
+
+// T2 has methods that cannot be mocked outside of pkg.
+type T2 struct{ _ bool }
+
+type secret int
+
+func (T2) Visible() Int         { return 2 }
+func (T2) Secret() secret       { return 0 }
+func (T2) Hidden() hidden.T     { return hidden.T{} }
+func (T2) Internal() []hidden.T { return nil }
+
+type User struct{ Name string }
@@ -0,0 +1,6 @@
+package testdata
+
+import "lesiw.io/moxie/internal/testdata/pkg"
+
+// Value is named after the Value method it promotes from pkg.T1.
+type Value struct{ pkg.T1 }
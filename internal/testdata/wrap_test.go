@@ -0,0 +1,79 @@
+package testdata
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"lesiw.io/moxie/internal/testdata/pkg"
+)
+
+func TestWrapNilEmbed(t *testing.T) {
+	var rw FakeRW
+	defer func() {
+		r := recover()
+		msg, _ := r.(string)
+		want := "FakeRW.Read: not mocked and embedded io.ReadWriter is nil"
+		if msg != want {
+			t.Errorf("recover(): want %q, got %v", want, r)
+		}
+	}()
+	_, _ = rw.Read(nil)
+}
+
+func TestWrapMocked(t *testing.T) {
+	var rw FakeRW
+	rw._Read_Return(3, nil)
+	if n, err := rw.Read(nil); n != 3 || err != nil {
+		t.Errorf("FakeRW.Read(): want (3, <nil>), got (%d, %v)", n, err)
+	}
+	if got, want := len(rw._Read_Calls()), 1; got != want {
+		t.Errorf("FakeRW._Read_Calls(): want %d calls, got %d", want, got)
+	}
+}
+
+func TestWrapEmbed(t *testing.T) {
+	rw := FakeRW{ReadWriter: bytes.NewBufferString("embedded")}
+	rw._Write_Stub()
+	if _, err := rw.Write([]byte("mocked")); err != nil {
+		t.Fatalf("FakeRW.Write(): %v", err)
+	}
+	buf := make([]byte, 16)
+	n, _ := rw.Read(buf)
+	if got, want := string(buf[:n]), "embedded"; got != want {
+		t.Errorf("FakeRW.Read(): want %q, got %q", want, got)
+	}
+}
+
+func TestWrapConcrete(t *testing.T) {
+	var t1 FakeT1
+	if got, want := t1.Value(), 1; int(got) != want {
+		t.Errorf("FakeT1.Value(): want %d, got %d", want, got)
+	}
+	t1._Value_Return(42)
+	if got, want := t1.Value(), 42; int(got) != want {
+		t.Errorf("FakeT1.Value(): want %d, got %d", want, got)
+	}
+}
+
+func TestWrapMethods(t *testing.T) {
+	r := FakeReader{ReadWriter: new(bytes.Buffer)}
+	r._Read_Return(0, io.ErrUnexpectedEOF)
+	if _, err := r.Write([]byte("data")); err != nil {
+		t.Fatalf("FakeReader.Write(): %v", err)
+	}
+	if _, err := r.Read(nil); err != io.ErrUnexpectedEOF {
+		t.Errorf("FakeReader.Read(): want %v, got %v", io.ErrUnexpectedEOF, err)
+	}
+}
+
+func TestWrapUnreferable(t *testing.T) {
+	var t2 FakeT2
+	t2._Visible_Return(42)
+	if got, want := t2.Visible(), pkg.Int(42); got != want {
+		t.Errorf("FakeT2.Visible(): want %d, got %d", want, got)
+	}
+	if got := t2.Secret(); got != 0 { // Promoted from pkg.T2.
+		t.Errorf("FakeT2.Secret(): want 0, got %d", got)
+	}
+}
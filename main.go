@@ -1,32 +1,56 @@
 package main
 
 import (
-	"cmp"
 	_ "embed"
 	"fmt"
-	"go/format"
-	"go/types"
 	"os"
-	"slices"
+	"strconv"
 	"strings"
-	"unicode"
-
-	"golang.org/x/tools/go/packages"
 
 	"lesiw.io/flag"
+	"lesiw.io/moxie/gen"
 )
 
 var (
-	typename string
-	pkgname  string
-	flags    = flag.NewSet(os.Stderr, "moxie TYPE")
+	flags = flag.NewSet(os.Stderr,
+		"moxie [TYPE]\n       moxie test [GOTESTFLAGS] [PACKAGES]")
 	printver = flags.Bool("V,version", "print version and exit")
-	imports  = map[string]string{
-		"runtime": "runtime",
-		"sync":    "sync",
-		"testing": "testing",
-		"unsafe":  "unsafe",
-	}
+	recflags = flags.Strings("record",
+		"call recording `limit` for all methods, or METHOD=limit for one.\n"+
+			"Negative limits record every call. 0 disables recording.",
+	)
+	wrapflag = flags.String("wrap",
+		"generate a struct embedding `path.TYPE` instead of using TYPE.")
+	nameflag = flags.String("name",
+		"`name` of the struct generated by --wrap.\n"+
+			"Defaults to the name of the wrapped type.")
+	funcflag = flags.String("func",
+		"mock the package-level function variable `name` instead of TYPE.")
+	typeflag = flags.String("functype",
+		"generate a recording constructor for the function type `name`.")
+	exportflag = flags.Bool("export",
+		"write exported helpers to a non-test file behind the moxie build tag.")
+	fluentflag = flags.Bool("fluent",
+		"also generate a Mock() method returning a controller for each method.")
+	methflags = flags.Strings("method",
+		"mock only the method `name`. May be repeated.")
+	exclflags = flags.Strings("exclude",
+		"do not mock the method `name`. May be repeated.")
+	pickflags = flags.Strings("pick",
+		"mock METHOD=`field`, a method promoted ambiguously from several "+
+			"embedded fields,\ndelegating to field. May be repeated.")
+	proxyflags = flags.Strings("proxy",
+		"mock the unexported method `name` declared on TYPE through an "+
+			"exported proxy,\nsuch as Validate for validate. May be repeated.")
+	tagsflag = flags.String("tags",
+		"comma-separated `list` of build tags to load packages with,\n"+
+			"in addition to those in GOFLAGS.")
+	localflag = flags.String("local",
+		"comma-separated `list` of import path prefixes to group last.\n"+
+			"Defaults to the current module.")
+	tmplflag = flags.String("template",
+		"`dir`ectory of *.tmpl files adding to or replacing the default "+
+			"templates.")
 
 	//go:embed version.txt
 	versionfile string
@@ -46,6 +70,15 @@ func main() {
 }
 
 func run(args ...string) error {
+	if len(args) > 0 && args[0] == "test" {
+		return runtest(args[1:]...)
+	}
+	flags.Args = nil
+	*recflags, *methflags, *exclflags = nil, nil, nil
+	*pickflags, *proxyflags = nil, nil
+	*wrapflag, *nameflag, *funcflag, *typeflag = "", "", "", ""
+	*exportflag, *fluentflag = false, false
+	*tmplflag, *tagsflag, *localflag = "", "", ""
 	if err := flags.Parse(args...); err != nil {
 		return fmt.Errorf("")
 	}
@@ -53,399 +86,135 @@ func run(args ...string) error {
 		fmt.Println(version)
 		return nil
 	}
-	if len(flags.Args) < 1 {
-		flags.PrintError("bad type: no type provided")
-		return fmt.Errorf("")
-	}
-	typename = flags.Args[0]
-
-	cfg := &packages.Config{
-		Dir:   ".",
-		Mode:  packages.NeedTypes | packages.NeedName | packages.NeedTypesInfo,
-		Tests: true,
-	}
-	pkgs, err := packages.Load(cfg, "./...")
-	if err != nil {
-		return fmt.Errorf("failed to load packages: %w", err)
-	}
-	for _, pkg := range pkgs {
-		obj := pkg.Types.Scope().Lookup(typename)
-		if obj == nil {
-			continue
-		}
-		pkgname = pkg.Name
-		return generate(pkg.Types, obj.Type())
-	}
-	return fmt.Errorf("bad type: %s", typename)
-}
-
-func generate(pkg *types.Package, typ types.Type) error {
-	ntype, ok := typ.(*types.Named)
-	if !ok {
-		return fmt.Errorf("could not get name of type '%s'", typ)
-	}
-	st, ok := ntype.Underlying().(*types.Struct)
-	if !ok {
-		return fmt.Errorf("type is not a struct")
-	}
-	tname := ntype.Obj().Name()
-	fname := "mock_" + snakecase(tname) + "_test.go"
-	f, err := os.Create(fname)
-	if err != nil {
-		return fmt.Errorf("failed to open '%s' for writing: %w", fname, err)
-	}
-	defer f.Close()
-	var out strings.Builder
-
-	mset := types.NewMethodSet(typ)
-
-	out.WriteString(fmt.Sprintf(headerstart, pkgname, tname))
-	for sel := range mset.Methods() {
-		if !sel.Obj().Exported() {
-			continue
-		}
-		sig := types.SelectionString(sel, qualifier)
-		for range 2 {
-			_, sig, _ = strings.Cut(sig, " ") // Discard field.
-		}
-		mname, sig, _ := strings.Cut(sig, "(")
-		sig = "(" + sig
-		out.WriteString(fmt.Sprintf(funcinfo, tname, mname, sig))
-	}
-	out.WriteString(fmt.Sprintf(headerend, tname))
-
-	for sel := range mset.Methods() {
-		if !sel.Obj().Exported() {
-			continue
-		}
-		sig := types.SelectionString(sel, qualifier)
-		for range 2 {
-			_, sig, _ = strings.Cut(sig, " ") // Discard field.
-		}
-		mname, _, _ := strings.Cut(sig, "(")
-		tsig := sel.Obj().Type().(*types.Signature)
-		out.WriteString(fmt.Sprintf(calltype, tname, mname, paramfields(tsig)))
-	}
-
-	for sel := range mset.Methods() {
-		if !sel.Obj().Exported() {
-			continue
+	var modes int
+	for _, set := range []bool{
+		len(flags.Args) > 0, *wrapflag != "", *funcflag != "", *typeflag != "",
+	} {
+		if set {
+			modes++
 		}
-		tsig := sel.Obj().Type().(*types.Signature)
-		mname := sel.Obj().Name()
-		obj, idx, _ := types.LookupFieldOrMethod(typ, true, pkg, mname)
-		var callorig string
-		if obj != nil {
-			callorig = fmt.Sprintf(
-				" else {\n\t\t_fn = _recv.%s.%s\n\t}",
-				st.Field(idx[0]).Name(),
-				mname,
-			)
-		}
-		out.WriteString(
-			fmt.Sprintf(
-				fn,
-				tname,
-				mname,
-				sig(sel),
-				args(tsig.Params(), tsig.Variadic()),
-				callorig,
-				args(tsig.Params(), false),
-				argtypes(tsig.Params(), tsig.Variadic()),
-				resultparams(tsig.Results()),
-				resulttypes(tsig.Results()),
-				resultargs(tsig.Results()),
-				ternary(tsig.Results().Len() > 0, "return ", ""),
-			),
-		)
-	}
-	src := strings.Replace(out.String(), "import()", importblock(), 1)
-	formatted, err := format.Source([]byte(src))
-	if err != nil {
-		return fmt.Errorf("failed to format generated source: %w", err)
 	}
-	_, _ = f.Write(formatted)
-
-	return nil
-}
-
-func snakecase(s string) string {
-	var result strings.Builder
-	for i, r := range s {
-		if unicode.IsUpper(r) {
-			if i > 0 {
-				result.WriteByte('_')
-			}
-			result.WriteRune(unicode.ToLower(r))
-		} else {
-			result.WriteRune(r)
-		}
+	if modes > 1 {
+		flags.PrintError(
+			"bad type: use only one of TYPE, --wrap, --func, and --functype")
+		return fmt.Errorf("")
 	}
-	return result.String()
-}
-
-func sig(sel *types.Selection) string {
-	var b strings.Builder
-	b.WriteString(sel.Obj().Name())
-	sig := sel.Obj().Type().(*types.Signature)
-	b.WriteString("(")
-	names := paramnames(sig.Params(), false)
-	for i := range sig.Params().Len() {
-		p := sig.Params().At(i)
-		if i > 0 {
-			b.WriteString(", ")
-		}
-		b.WriteString(names[i])
-		b.WriteString(" ")
-		if i == sig.Params().Len()-1 && sig.Variadic() {
-			b.WriteString("...")
-			b.WriteString(types.TypeString(p.Type(), qualifier)[2:])
-		} else {
-			b.WriteString(types.TypeString(p.Type(), qualifier))
+	if modes < 1 {
+		file, err := findconfig(".")
+		if err != nil {
+			return fmt.Errorf("failed to find %s: %w", configfile, err)
 		}
-	}
-	b.WriteString(")")
-	if sig.Results().Len() > 0 {
-		b.WriteString(" ")
-		if sig.Results().Len() > 1 {
-			b.WriteString("(")
+		if file == "" {
+			flags.PrintError("bad type: no type provided")
+			return fmt.Errorf("")
 		}
-		for i := range sig.Results().Len() {
-			r := sig.Results().At(i)
-			if i > 0 {
-				b.WriteString(", ")
+		// Mock options belong in moxie.json; only --tags applies to it.
+		for _, opt := range []struct {
+			name string
+			set  bool
+		}{
+			{"export", *exportflag},
+			{"fluent", *fluentflag},
+			{"record", len(*recflags) > 0},
+			{"method", len(*methflags) > 0},
+			{"exclude", len(*exclflags) > 0},
+			{"pick", len(*pickflags) > 0},
+			{"proxy", len(*proxyflags) > 0},
+			{"local", *localflag != ""},
+			{"template", *tmplflag != ""},
+			{"name", *nameflag != ""},
+		} {
+			if opt.set {
+				flags.PrintError(fmt.Sprintf("bad %s: --%s requires TYPE, "+
+					"--wrap, --func, or --functype", opt.name, opt.name))
+				return fmt.Errorf("")
 			}
-			b.WriteString(types.TypeString(r.Type(), qualifier))
-		}
-		if sig.Results().Len() > 1 {
-			b.WriteString(")")
-		}
-	}
-	return b.String()
-}
-
-func args(tup *types.Tuple, variadic bool) string {
-	var (
-		b     strings.Builder
-		names = paramnames(tup, false)
-	)
-	for i := range tup.Len() {
-		if i > 0 {
-			b.WriteString(", ")
-		}
-		b.WriteString(names[i])
-		if i == tup.Len()-1 && variadic {
-			b.WriteString("...")
-		}
-	}
-	return b.String()
-}
-
-func argtypes(tup *types.Tuple, variadic bool) string {
-	var b strings.Builder
-	for i := range tup.Len() {
-		v := tup.At(i)
-		if i > 0 {
-			b.WriteString(", ")
-		}
-		s := types.TypeString(v.Type(), qualifier)
-		if i == tup.Len()-1 && variadic {
-			s = strings.TrimPrefix(s, "[]")
-			s = "..." + s
 		}
-		b.WriteString(s)
+		return generateall(file, splittags(*tagsflag))
 	}
-	return b.String()
-}
-
-func resultparams(tup *types.Tuple) string {
-	var (
-		b     strings.Builder
-		names = resultnames(tup)
-	)
-	for i := range tup.Len() {
-		v := tup.At(i)
-		if i > 0 {
-			b.WriteString(", ")
-		}
-		b.WriteString(names[i])
-		b.WriteString(" " + types.TypeString(v.Type(), qualifier))
-	}
-	return b.String()
-}
-
-func resulttypes(tup *types.Tuple) string {
-	var b strings.Builder
-	for i := range tup.Len() {
-		v := tup.At(i)
-		if i > 0 {
-			b.WriteString(", ")
-		}
-		b.WriteString(types.TypeString(v.Type(), qualifier))
-	}
-	return b.String()
-}
-
-func resultargs(tup *types.Tuple) string {
-	var (
-		b     strings.Builder
-		names = resultnames(tup)
-	)
-	for i := range tup.Len() {
-		if i > 0 {
-			b.WriteString(", ")
-		}
-		b.WriteString(names[i])
-	}
-	return b.String()
-}
-
-func qualifier(pkg *types.Package) string {
-	if name, ok := imports[pkg.Path()]; ok {
-		return name
-	}
-	name := pkg.Name()
-	if name == pkgname {
-		name = ""
-	}
-pickname:
-	for _, v := range imports {
-		if v == name {
-			name = name + "_"
-			goto pickname
-		}
+	if (*funcflag != "" || *typeflag != "") && len(*recflags) > 0 {
+		flags.PrintError("bad record: --func and --functype record every call")
+		return fmt.Errorf("")
 	}
-	imports[pkg.Path()] = name
-	return imports[pkg.Path()]
-}
-
-func importblock() string {
-	var std, ext, local []string
-	for _, path := range keys(imports) {
-		name := imports[path]
-		if name == "" {
-			continue
-		}
-		var line string
-		if name == path {
-			line = fmt.Sprintf("\t%q\n", name)
-		} else {
-			line = fmt.Sprintf("\t%s %q\n", name, path)
-		}
-		switch {
-		case strings.HasPrefix(path, "lesiw.io/"),
-			strings.HasPrefix(path, "labs.lesiw.io/"):
-			local = append(local, line)
-		case strings.Contains(strings.SplitN(path, "/", 2)[0], "."):
-			ext = append(ext, line)
-		default:
-			std = append(std, line)
-		}
+	if *fluentflag && (*funcflag != "" || *typeflag != "") {
+		flags.PrintError("bad fluent: --fluent requires TYPE or --wrap")
+		return fmt.Errorf("")
 	}
-	var b strings.Builder
-	b.WriteString("import (\n")
-	for i, group := range [][]string{std, ext, local} {
-		if len(group) == 0 {
-			continue
-		}
-		if i > 0 && b.Len() > len("import (\n") {
-			b.WriteString("\n")
-		}
-		for _, line := range group {
-			b.WriteString(line)
-		}
+	if len(flags.Args) == 0 && len(*pickflags) > 0 {
+		flags.PrintError("bad pick: --pick requires TYPE")
+		return fmt.Errorf("")
 	}
-	b.WriteString(")")
-
-	return b.String()
-}
-
-func paramfields(sig *types.Signature) string {
-	params := sig.Params()
-	if params.Len() == 0 {
-		return ""
+	if len(flags.Args) == 0 && len(*proxyflags) > 0 {
+		flags.PrintError("bad proxy: --proxy requires TYPE")
+		return fmt.Errorf("")
 	}
-	var b strings.Builder
-	b.WriteString("\n")
-	names := paramnames(params, true)
-	for i := range params.Len() {
-		if i > 0 {
-			b.WriteString("\n")
-		}
-		param := params.At(i)
-		b.WriteString(fmt.Sprintf("\t%s %s",
-			names[i],
-			types.TypeString(param.Type(), qualifier),
-		))
+	if *wrapflag == "" && *nameflag != "" {
+		flags.PrintError("bad name: --name requires --wrap")
+		return fmt.Errorf("")
 	}
-	b.WriteString("\n")
-	return b.String()
-}
-
-func paramnames(tup *types.Tuple, export bool) []string {
-	names := make([]string, 0, tup.Len())
-	for i := range tup.Len() {
-		var (
-			v    = tup.At(i)
-			name string
-		)
-		if v.Name() == "" {
-			name = fmt.Sprintf("P%d", i)
-		} else {
-			name = v.Name()
-			if export {
-				name = capitalize(name)
-			}
-		}
-		for slices.Contains(names, name) {
-			name = name + "_"
-		}
-		names = append(names, name)
+	cfg := gen.Config{
+		Wrap:     *wrapflag,
+		Name:     *nameflag,
+		Func:     *funcflag,
+		FuncType: *typeflag,
+		Export:   *exportflag,
+		Fluent:   *fluentflag,
+		Template: *tmplflag,
+		Methods:  *methflags,
+		Exclude:  *exclflags,
+		Proxy:    *proxyflags,
+		Tags:     splittags(*tagsflag),
+		Local:    splittags(*localflag),
+		Warnf:    warnf,
+	}
+	if len(flags.Args) > 0 {
+		cfg.Type = flags.Args[0]
+	}
+	for _, rec := range *recflags {
+		mname, limit, ok := strings.Cut(rec, "=")
+		if !ok {
+			mname, limit = "", rec
+		}
+		n, err := strconv.Atoi(limit)
+		if err != nil {
+			flags.PrintError(fmt.Sprintf("bad record: %s", rec))
+			return fmt.Errorf("")
+		}
+		if cfg.Record == nil {
+			cfg.Record = make(map[string]int)
+		}
+		cfg.Record[mname] = n
+	}
+	for _, pick := range *pickflags {
+		mname, field, ok := strings.Cut(pick, "=")
+		if !ok || mname == "" || field == "" {
+			flags.PrintError(fmt.Sprintf("bad pick: %s", pick))
+			return fmt.Errorf("")
+		}
+		if cfg.Pick == nil {
+			cfg.Pick = make(map[string]string)
+		}
+		cfg.Pick[mname] = field
+	}
+	src, err := gen.Generate(cfg)
+	if err != nil {
+		return err
 	}
-	return names
-}
-
-func resultnames(tup *types.Tuple) []string {
-	names := make([]string, 0, tup.Len())
-	for i := range tup.Len() {
-		var (
-			v    = tup.At(i)
-			name string
-		)
-		if v.Name() == "" {
-			name = fmt.Sprintf("r%d", i)
-		} else if v.Name() == "error" {
-			// Fix a common type shadowing error.
-			name = "err"
-		} else {
-			name = v.Name()
-		}
-		for slices.Contains(names, name) {
-			name = name + "_"
-		}
-		names = append(names, name)
+	fname := cfg.Filename()
+	if err := os.WriteFile(fname, src, 0644); err != nil {
+		return fmt.Errorf("failed to write '%s': %w", fname, err)
 	}
-	return names
+	return nil
 }
 
-func ternary[T any](cond bool, t, f T) T {
-	if cond {
-		return t
-	} else {
-		return f
-	}
+func warnf(format string, args ...any) {
+	fmt.Fprintf(os.Stderr, "warning: "+format+"\n", args...)
 }
 
-func keys[M ~map[K]V, K cmp.Ordered, V any](m M) []K {
-	r := make([]K, 0, len(m))
-	for k := range m {
-		r = append(r, k)
+// splittags splits a comma-separated list of build tags.
+func splittags(list string) []string {
+	if list == "" {
+		return nil
 	}
-	slices.Sort(r)
-	return r
-}
-
-func capitalize(s string) string {
-	runes := []rune(s)
-	return string(append([]rune{unicode.ToUpper(runes[0])}, runes[1:]...))
+	return strings.Split(list, ",")
 }
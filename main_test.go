@@ -1,9 +1,11 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"os"
 	"path/filepath"
+	"slices"
 	"testing"
 
 	"lesiw.io/command"
@@ -23,21 +25,68 @@ func TestMoxie(t *testing.T) {
 	if err != nil {
 		t.Fatalf("failed to match mock files: %s", err)
 	}
+	want := make(map[string][]byte, len(matches))
 	for _, file := range matches {
-		if err := os.Remove(file); err != nil {
-			t.Fatalf("failed to remove %q: %s", file, err)
+		if want[file], err = os.ReadFile(file); err != nil {
+			t.Fatalf("failed to read %q: %s", file, err)
 		}
 	}
-	if err := run("M0"); err != nil {
-		t.Fatalf("failed to run moxie: %s", err)
+	// Test against mocks rendered only into the build overlay,
+	// built with the tagged tag from moxie.json as well.
+	args := []string{
+		"test", "-count", "1", "-shuffle", "on", "-bench", ".",
+		"-benchtime", "1x", "-tags", "moxie",
 	}
-	args := []string{"go", "test", "-v", "-shuffle", "on"}
 	if raceEnabled() {
 		args = append(args, "-race")
 	}
-	args = append(args, ".")
-	err = sh.Exec(t.Context(), args...)
+	if err := run(append(args, ".")...); err != nil {
+		t.Fatalf("failed to run moxie test: %s", err)
+	}
+	// Regenerate the mocks in place, which must leave them unchanged.
+	for _, args := range [][]string{
+		{}, // Generate everything in moxie.json.
+		{"--export", "--fluent", "M2"},
+	} {
+		if err := run(args...); err != nil {
+			t.Fatalf("failed to run moxie %v: %s", args, err)
+		}
+	}
+	for _, file := range matches {
+		got, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("failed to read %q: %s", file, err)
+		}
+		if !bytes.Equal(got, want[file]) {
+			t.Errorf("moxie changed %q; commit the regenerated mock", file)
+		}
+	}
+}
+
+func TestMoxieConfigFlags(t *testing.T) {
+	t.Chdir("internal/testdata/")
+	for _, args := range [][]string{
+		{"--export"},
+		{"--record", "1"},
+		{"--exclude", "Read"},
+		{"--template", "templates"},
+	} {
+		if err := run(args...); err == nil {
+			t.Errorf("moxie %v with moxie.json: want error, got <nil>", args)
+		}
+	}
+}
+
+func TestSettags(t *testing.T) {
+	t.Setenv("GOFLAGS", "-tags=b")
+	args := []string{"-run", "Test", "-tags", "c", "./..."}
+	got, err := settags(args, []string{"a", "c"})
 	if err != nil {
-		t.Fatalf("failed to run tests: %s", err)
+		t.Fatal(err)
+	}
+	want := []string{"-tags=b,a,c", "-run", "Test", "./..."}
+	if !slices.Equal(got, want) {
+		t.Errorf("settags(%q) with GOFLAGS=-tags=b: want %q, got %q",
+			args, want, got)
 	}
 }
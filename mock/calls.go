@@ -0,0 +1,40 @@
+package mock
+
+// A CallLog records calls of type C.
+type CallLog[C any] struct {
+	buf   []C
+	start int
+}
+
+// Add records call.
+// If limit is positive, only the most recent limit calls are kept.
+func (l *CallLog[C]) Add(call C, limit int) {
+	switch {
+	case limit > 0 && len(l.buf) == limit:
+		l.buf[l.start] = call
+		l.start = (l.start + 1) % limit
+		return
+	case limit > 0 && len(l.buf) > limit:
+		l.buf = l.Calls()[len(l.buf)-limit+1:]
+	case l.start > 0:
+		l.buf = l.Calls()
+	}
+	l.start = 0
+	l.buf = append(l.buf, call)
+}
+
+// Calls returns the recorded calls, oldest first.
+func (l *CallLog[C]) Calls() []C {
+	calls := make([]C, 0, len(l.buf))
+	calls = append(calls, l.buf[l.start:]...)
+	return append(calls, l.buf[:l.start]...)
+}
+
+// Clear removes all recorded calls.
+func (l *CallLog[C]) Clear() {
+	l.buf = nil
+	l.start = 0
+}
+
+// Len returns the number of recorded calls.
+func (l *CallLog[C]) Len() int { return len(l.buf) }
@@ -0,0 +1,49 @@
+package mock
+
+import (
+	"context"
+	"sync"
+)
+
+type ctxqueue[F any] struct {
+	mutex sync.Mutex
+	mocks Queue[F]
+}
+
+// WithContext returns a copy of ctx that queues fn as a mock under key,
+// after any mocks already queued under key in ctx.
+// If fn is nil, the returned context has no mocks under key.
+func WithContext[F any](ctx context.Context, key any, fn F) context.Context {
+	q := new(ctxqueue[F])
+	if prev, ok := ctx.Value(key).(*ctxqueue[F]); ok {
+		prev.mutex.Lock()
+		q.mocks = prev.mocks.clone()
+		prev.mutex.Unlock()
+	}
+	if isnil(fn) {
+		q.mocks.Clear()
+	} else {
+		q.mocks.Push(fn)
+	}
+	return context.WithValue(ctx, key, q)
+}
+
+// FromContext returns the next mock queued under key in ctx.
+// It returns false if there is none.
+func FromContext[F any](ctx context.Context, key any) (fn F, ok bool) {
+	if ctx == nil {
+		return fn, false
+	}
+	q, _ := ctx.Value(key).(*ctxqueue[F])
+	if q == nil {
+		return fn, false
+	}
+	defer q.mutex.Unlock()
+	q.mutex.Lock()
+	return q.mocks.Next()
+}
+
+// InContext reports whether ctx has mocks under key.
+func InContext(ctx context.Context, key any) bool {
+	return ctx != nil && ctx.Value(key) != nil
+}
@@ -0,0 +1,88 @@
+package mock
+
+import (
+	"sync"
+	"testing"
+)
+
+// A Callback holds the mocks and calls of a function value.
+//
+// The zero value is ready to use.
+type Callback[F, C any] struct {
+	mutex  sync.Mutex
+	method Method[F, C]
+}
+
+// Do queues fn as a mock of the function.
+// If fn is nil, Do removes all mocks instead.
+func (c *Callback[F, C]) Do(fn F) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.push(fn)
+}
+
+func (c *Callback[F, C]) push(fn F) {
+	if isnil(fn) {
+		c.method.Mocks.Clear()
+	} else {
+		c.method.Mocks.Push(fn)
+	}
+}
+
+// Next records call and returns the mock to call in its place, if there is
+// one.
+func (c *Callback[F, C]) Next(call C) (fn F, ok bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.method.Calls.Add(call, -1)
+	return c.method.Mocks.Next()
+}
+
+// Calls returns the recorded calls.
+func (c *Callback[F, C]) Calls() []C {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.method.Calls.Calls()
+}
+
+// A Func holds the mocks and calls of a package-level function variable.
+//
+// The zero value is ready to use.
+type Func[F, C any] struct {
+	Callback[F, C]
+	installed bool
+}
+
+// Do queues fn as a mock of the function variable v until the end of the test.
+// If fn is nil, Do removes all mocks instead.
+//
+// The first call sets v to proxy(*v), and restores v at the end of the test.
+func (f *Func[F, C]) Do(t testing.TB, v *F, proxy func(F) F, fn F) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.install(t, v, proxy)
+	f.push(fn)
+}
+
+func (f *Func[F, C]) install(t testing.TB, v *F, proxy func(F) F) {
+	if f.installed {
+		mocks := f.method.Mocks.clone()
+		t.Cleanup(func() {
+			f.mutex.Lock()
+			defer f.mutex.Unlock()
+			f.method.Mocks = mocks
+		})
+		return
+	}
+	orig := *v
+	*v = proxy(orig)
+	f.installed = true
+	t.Cleanup(func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		*v = orig
+		f.installed = false
+		f.method.Mocks.Clear()
+		f.method.Calls.Clear()
+	})
+}
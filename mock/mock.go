@@ -0,0 +1,260 @@
+// Package mock is the runtime support for code generated by lesiw.io/moxie.
+//
+// Generated code is a thin layer over this package. Its API is not intended to
+// be used directly, and may change between versions of moxie.
+package mock
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"weak"
+
+	"lesiw.io/moxie/mock/state"
+)
+
+// A Registry holds the mock state D of values of type T.
+//
+// The zero value is ready to use.
+type Registry[T, D any] struct {
+	entries sync.Map // weak.Pointer[T] -> *Entry[D]
+	once    sync.Once
+	global  Entry[D]
+	queues  atomic.Int64
+}
+
+// An Entry holds the mock state D of a single value, or of all values.
+type Entry[D any] struct {
+	mutex  sync.Mutex
+	data   D
+	queues int
+	total  *atomic.Int64
+}
+
+// A Method holds the mocks and calls of a method.
+type Method[F, C any] struct {
+	Mocks Queue[F]
+	Calls CallLog[C]
+}
+
+// Get returns the entry for t, creating it if needed.
+// If t is nil, Get returns the global entry.
+//
+// The entry is removed once t is garbage collected.
+func (r *Registry[T, D]) Get(t *T) *Entry[D] {
+	if t == nil {
+		return r.Global()
+	}
+	key := weak.Make(t)
+	if e, ok := r.entries.Load(key); ok {
+		return e.(*Entry[D])
+	}
+	e, loaded := r.entries.LoadOrStore(key, r.New())
+	if !loaded {
+		runtime.AddCleanup(t, r.release, key)
+	}
+	return e.(*Entry[D])
+}
+
+// New returns a new entry that is not tied to the address of any value.
+func (r *Registry[T, D]) New() *Entry[D] {
+	return &Entry[D]{total: &r.queues}
+}
+
+// State returns the entry held in s, storing a new entry in s if it is empty.
+// Concurrent first uses of s return the same entry.
+func (r *Registry[T, D]) State(s *state.State) *Entry[D] {
+	if e, ok := s.Load().(*Entry[D]); ok {
+		return e
+	}
+	return s.Init(r.New()).(*Entry[D])
+}
+
+// Global returns the entry that applies to all values.
+func (r *Registry[T, D]) Global() *Entry[D] {
+	r.once.Do(func() { r.global.total = &r.queues })
+	return &r.global
+}
+
+// Idle reports whether no value has mocks and p records no calls.
+func (r *Registry[T, D]) Idle(p *Policy) bool {
+	return r.queues.Load() == 0 && p.Idle()
+}
+
+func (r *Registry[T, D]) release(key weak.Pointer[T]) {
+	e, ok := r.entries.LoadAndDelete(key)
+	if !ok {
+		return
+	}
+	e.(*Entry[D]).Reset()
+}
+
+// Lock locks e and returns its data.
+func (e *Entry[D]) Lock() *D {
+	e.mutex.Lock()
+	return &e.data
+}
+
+// Unlock unlocks e.
+func (e *Entry[D]) Unlock() { e.mutex.Unlock() }
+
+// Reset clears all mocks and calls in e.
+func (e *Entry[D]) Reset() {
+	defer e.mutex.Unlock()
+	e.mutex.Lock()
+	var zero D
+	e.data = zero
+	e.total.Add(-int64(e.queues))
+	e.queues = 0
+}
+
+func (e *Entry[D]) update(q interface{ Empty() bool }, fn func()) {
+	before := q.Empty()
+	fn()
+	switch after := q.Empty(); {
+	case before && !after:
+		e.queues++
+		e.total.Add(1)
+	case !before && after:
+		e.queues--
+		e.total.Add(-1)
+	}
+}
+
+// Do queues fn as a mock of the method m of e.
+// If fn is nil, Do removes all mocks of the method instead.
+func Do[D, F, C any](e *Entry[D], m func(*D) *Method[F, C], fn F) {
+	defer e.Unlock()
+	q := &m(e.Lock()).Mocks
+	e.update(q, func() {
+		if isnil(fn) {
+			q.Clear()
+		} else {
+			q.Push(fn)
+		}
+	})
+}
+
+// DoT is like Do, but restores the mocks of the method and clears its calls
+// at the end of the test.
+func DoT[D, F, C any](
+	t testing.TB, e *Entry[D], m func(*D) *Method[F, C], fn F,
+) {
+	scope(t, e, m, true)
+	Do(e, m, fn)
+}
+
+// DoAll is like Do, but restores the mocks of the method at the end of the
+// test.
+func DoAll[D, F, C any](
+	t testing.TB, e *Entry[D], m func(*D) *Method[F, C], fn F,
+) {
+	scope(t, e, m, false)
+	Do(e, m, fn)
+}
+
+func scope[D, F, C any](
+	t testing.TB, e *Entry[D], m func(*D) *Method[F, C], calls bool,
+) {
+	defer e.Unlock()
+	prev := m(e.Lock()).Mocks.clone()
+	t.Cleanup(func() {
+		defer e.Unlock()
+		method := m(e.Lock())
+		e.update(&method.Mocks, func() { method.Mocks = prev })
+		if calls {
+			method.Calls.Clear()
+		}
+	})
+}
+
+// Next records call to the method m of e and all, and returns the mock to
+// call in its place, if there is one.
+//
+// Mocks on e take precedence over mocks on all.
+func Next[D, F, C any](
+	e, all *Entry[D], m func(*D) *Method[F, C], p *Policy, call C,
+) (F, bool) {
+	return NextContext(nil, nil, e, all, m, p, call)
+}
+
+// NextContext is like Next, but mocks stored in ctx under key take precedence
+// over mocks on all.
+func NextContext[D, F, C any](
+	ctx context.Context, key any,
+	e, all *Entry[D], m func(*D) *Method[F, C], p *Policy, call C,
+) (fn F, ok bool) {
+	limit := p.Limit()
+	method := m(e.Lock())
+	if limit != 0 {
+		method.Calls.Add(call, limit)
+	}
+	fn, ok = method.Mocks.Next()
+	e.Unlock()
+	if !ok && key != nil {
+		fn, ok = FromContext[F](ctx, key)
+	}
+	if global := p.Global(); !ok || global {
+		method := m(all.Lock())
+		if global && limit != 0 {
+			method.Calls.Add(call, limit)
+		}
+		if !ok {
+			fn, ok = method.Mocks.Next()
+		}
+		all.Unlock()
+	}
+	return fn, ok
+}
+
+// Calls returns the calls recorded to the method m of e.
+func Calls[D, F, C any](e *Entry[D], m func(*D) *Method[F, C]) []C {
+	defer e.Unlock()
+	return m(e.Lock()).Calls.Calls()
+}
+
+// Bubble enables recording to the global call log for each policy in ps until
+// the end of the test, and calls clear on the data of e before and after.
+func Bubble[D any](t testing.TB, e *Entry[D], clear func(*D), ps ...*Policy) {
+	e.mutex.Lock()
+	clear(&e.data)
+	for _, p := range ps {
+		p.bubbles.Add(1)
+	}
+	e.mutex.Unlock()
+	t.Cleanup(func() {
+		defer e.Unlock()
+		clear(e.Lock())
+		for _, p := range ps {
+			p.bubbles.Add(-1)
+		}
+	})
+}
+
+// Serial fails the test if it or any of its parents is parallel.
+func Serial(t testing.TB, name string) {
+	t.Helper()
+	if parallel(t) {
+		t.Fatalf("%s: global mocks cannot be used in parallel tests", name)
+	}
+}
+
+func parallel(t testing.TB) (parallel bool) {
+	defer func() { parallel = recover() != nil }()
+	// Setenv panics if the test or any of its ancestors is parallel.
+	t.Setenv("MOXIE_SERIAL", os.Getenv("MOXIE_SERIAL"))
+	return
+}
+
+func isnil[F any](fn F) bool {
+	v := reflect.ValueOf(&fn).Elem()
+	switch v.Kind() {
+	case reflect.Func, reflect.Interface, reflect.Pointer:
+		return v.IsNil()
+	}
+	return false
+}
@@ -0,0 +1,140 @@
+package mock
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestQueue(t *testing.T) {
+	var q Queue[int]
+	if _, ok := q.Next(); ok {
+		t.Fatal("Next() on empty queue: want false, got true")
+	}
+	q.Push(1)
+	q.Push(2)
+	var got []int
+	for range 3 {
+		n, _ := q.Next()
+		got = append(got, n)
+	}
+	q.Push(3)
+	n, _ := q.Next()
+	got = append(got, n)
+	if want := []int{1, 2, 2, 3}; !slices.Equal(want, got) {
+		t.Errorf("Next(): want %v, got %v", want, got)
+	}
+	q.Clear()
+	if !q.Empty() {
+		t.Error("Empty() after Clear(): want true, got false")
+	}
+}
+
+func TestCallLog(t *testing.T) {
+	tests := []struct {
+		name  string
+		limit int
+		calls int
+		want  []int
+	}{
+		{"Unbounded", -1, 5, []int{0, 1, 2, 3, 4}},
+		{"UnderLimit", 5, 3, []int{0, 1, 2}},
+		{"AtLimit", 3, 3, []int{0, 1, 2}},
+		{"OverLimit", 3, 5, []int{2, 3, 4}},
+		{"Wrapped", 3, 7, []int{4, 5, 6}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var l CallLog[int]
+			for i := range tt.calls {
+				l.Add(i, tt.limit)
+			}
+			if got := l.Calls(); !slices.Equal(tt.want, got) {
+				t.Errorf("Calls(): want %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCallLogLimitChange(t *testing.T) {
+	var l CallLog[int]
+	for i := range 4 {
+		l.Add(i, 3)
+	}
+	l.Add(4, 2)
+	if got, want := l.Calls(), []int{3, 4}; !slices.Equal(want, got) {
+		t.Errorf("Calls() after lowering limit: want %v, got %v", want, got)
+	}
+	l.Add(5, -1)
+	if got, want := l.Calls(), []int{3, 4, 5}; !slices.Equal(want, got) {
+		t.Errorf("Calls() after removing limit: want %v, got %v", want, got)
+	}
+}
+
+type data struct {
+	M Method[func() int, struct{}]
+}
+
+func (d *data) m() *Method[func() int, struct{}] { return &d.M }
+
+func TestRegistryIdle(t *testing.T) {
+	var (
+		r Registry[int, data]
+		p Policy
+		v = new(int)
+	)
+	p.SetLimit(0)
+	if !r.Idle(&p) {
+		t.Fatal("Idle(): want true, got false")
+	}
+	Do(r.Get(v), (*data).m, func() int { return 1 })
+	if r.Idle(&p) {
+		t.Error("Idle() with mock: want false, got true")
+	}
+	Do(r.Get(v), (*data).m, nil)
+	if !r.Idle(&p) {
+		t.Error("Idle() after unmock: want true, got false")
+	}
+	Do(r.Get(v), (*data).m, func() int { return 1 })
+	r.Get(v).Reset()
+	if !r.Idle(&p) {
+		t.Error("Idle() after Reset(): want true, got false")
+	}
+}
+
+func TestNextRecordOffGlobal(t *testing.T) {
+	var (
+		r Registry[int, data]
+		p Policy
+		e = r.Get(new(int))
+	)
+	p.Record(t, 0)
+	Bubble(t, r.Global(), func(d *data) { d.M.Calls.Clear() }, &p)
+	if !r.Idle(&p) {
+		t.Error("Idle() with global calls: want true, got false")
+	}
+	for range 5 {
+		Next(e, r.Global(), (*data).m, &p, struct{}{})
+	}
+	if got := len(Calls(e, (*data).m)); got != 0 {
+		t.Errorf("Calls(): want 0 calls, got %d", got)
+	}
+	if got := len(Calls(r.Global(), (*data).m)); got != 0 {
+		t.Errorf("Calls() of global entry: want 0 calls, got %d", got)
+	}
+}
+
+func TestDoAllScope(t *testing.T) {
+	var r Registry[int, data]
+	Do(r.Global(), (*data).m, func() int { return 1 })
+	t.Run("Scope", func(t *testing.T) {
+		DoAll(t, r.Global(), (*data).m, func() int { return 2 })
+	})
+	var p Policy
+	fn, ok := Next(r.Get(new(int)), r.Global(), (*data).m, &p, struct{}{})
+	if !ok {
+		t.Fatal("Next(): want mock, got none")
+	}
+	if got, want := fn(), 1; got != want {
+		t.Errorf("mock after scope: want %d, got %d", want, got)
+	}
+}
@@ -0,0 +1,36 @@
+package mock
+
+import (
+	"sync/atomic"
+	"testing"
+)
+
+// A Policy controls how calls to a method are recorded.
+//
+// The zero value records every call to instance call logs,
+// and no calls to the global call log.
+type Policy struct {
+	limit   atomic.Int64 // Bitwise complement of the limit.
+	bubbles atomic.Int64
+}
+
+// Limit returns the number of calls recorded per call log.
+// A negative limit records every call. A limit of 0 records no calls.
+func (p *Policy) Limit() int { return int(^p.limit.Load()) }
+
+// SetLimit sets the number of calls recorded per call log.
+func (p *Policy) SetLimit(limit int) { p.limit.Store(^int64(limit)) }
+
+// Record sets the number of calls recorded per call log
+// until the end of the test.
+func (p *Policy) Record(t testing.TB, limit int) {
+	prev := p.limit.Swap(^int64(limit))
+	t.Cleanup(func() { p.limit.Store(prev) })
+}
+
+// Global reports whether calls are recorded to the global call log.
+func (p *Policy) Global() bool { return p.bubbles.Load() > 0 }
+
+// Idle reports whether calls are not recorded at all.
+// A limit of 0 records no calls to either call log.
+func (p *Policy) Idle() bool { return p.Limit() == 0 }
@@ -0,0 +1,43 @@
+package mock
+
+// A Queue holds the mocks of a function of type F.
+//
+// Mocks are returned in the order they were pushed.
+// The last mock repeats until another mock is pushed.
+type Queue[F any] struct {
+	fns []F
+}
+
+// Push adds fn to the end of the queue.
+func (q *Queue[F]) Push(fn F) {
+	// The last mock is stored twice until it is returned by Next, so that it
+	// can be replaced once it has started repeating.
+	if len(q.fns) < 2 {
+		q.fns = []F{fn, fn}
+	} else {
+		q.fns = append(q.fns[:len(q.fns)-1], fn, fn)
+	}
+}
+
+// Next returns the next mock in the queue.
+// It returns false if the queue is empty.
+func (q *Queue[F]) Next() (fn F, ok bool) {
+	if len(q.fns) == 0 {
+		return fn, false
+	}
+	fn = q.fns[0]
+	if len(q.fns) > 1 {
+		q.fns = q.fns[1:]
+	}
+	return fn, true
+}
+
+// Clear removes all mocks from the queue.
+func (q *Queue[F]) Clear() { q.fns = nil }
+
+// Empty reports whether the queue has no mocks.
+func (q *Queue[F]) Empty() bool { return len(q.fns) == 0 }
+
+func (q *Queue[F]) clone() Queue[F] {
+	return Queue[F]{append([]F(nil), q.fns...)}
+}
@@ -0,0 +1,28 @@
+// Package state holds the mock state of values that keep it in a field.
+//
+// It has no dependencies beyond the standard library's sync/atomic, so that
+// a production type can declare the field without linking lesiw.io/moxie/mock
+// and the testing package into its binaries.
+package state
+
+import "sync/atomic"
+
+// A State holds the mock state of a value in a field of the value, so that
+// copies of the value share its mocks and calls.
+//
+// The zero value is ready to use. It is filled in on first use, so copies made
+// before the first use of a mock or call do not share state: each is filled
+// in separately when it is first used.
+type State struct {
+	v atomic.Value
+}
+
+// Load returns the value stored in s, or nil if s is empty.
+func (s *State) Load() any { return s.v.Load() }
+
+// Init stores v in s if s is empty, and returns the value stored in s.
+// Concurrent calls to Init on an empty s all return the same value.
+func (s *State) Init(v any) any {
+	s.v.CompareAndSwap(nil, v)
+	return s.v.Load()
+}
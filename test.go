@@ -0,0 +1,118 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"lesiw.io/moxie/gen"
+)
+
+// An overlay is the -overlay file read by the go command.
+type overlay struct {
+	Replace map[string]string
+}
+
+// runtest renders every mock in moxie.json into a temporary directory and
+// runs go test with args, overlaying the mocks onto the source tree.
+// Packages are loaded and tests are built with the build tags in moxie.json
+// and in args.
+func runtest(args ...string) error {
+	file, err := findconfig(".")
+	if err != nil {
+		return fmt.Errorf("failed to find %s: %w", configfile, err)
+	}
+	if file == "" {
+		return fmt.Errorf("bad test: no %s found", configfile)
+	}
+	proj, err := readproject(file)
+	if err != nil {
+		return err
+	}
+	srcs, err := renderall(file, testtags(args))
+	if err != nil {
+		return err
+	}
+	args, err = settags(args, slices.Concat(proj.Tags, testtags(args)))
+	if err != nil {
+		return err
+	}
+	tmp, err := os.MkdirTemp("", "moxie")
+	if err != nil {
+		return fmt.Errorf("failed to create temp dir: %w", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	ovl := overlay{Replace: make(map[string]string, len(srcs))}
+	var i int
+	for fname, src := range srcs {
+		// Files keep their base names for compiler messages,
+		// prefixed to stay unique across packages.
+		tname := filepath.Join(tmp, strconv.Itoa(i)+"_"+filepath.Base(fname))
+		if err := os.WriteFile(tname, src, 0644); err != nil {
+			return fmt.Errorf("failed to write '%s': %w", tname, err)
+		}
+		ovl.Replace[fname] = tname
+		i++
+	}
+	buf, err := json.Marshal(ovl)
+	if err != nil {
+		return fmt.Errorf("failed to encode overlay: %w", err)
+	}
+	ovlfile := filepath.Join(tmp, "overlay.json")
+	if err := os.WriteFile(ovlfile, buf, 0644); err != nil {
+		return fmt.Errorf("failed to write '%s': %w", ovlfile, err)
+	}
+
+	cmd := exec.Command("go",
+		append([]string{"test", "-overlay", ovlfile}, args...)...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return fmt.Errorf("") // go test has already reported the failure.
+		}
+		return fmt.Errorf("failed to run go test: %w", err)
+	}
+	return nil
+}
+
+// testtags returns the build tags set by -tags in go test args.
+func testtags(args []string) []string {
+	for i, arg := range args {
+		name, value, ok := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		switch {
+		case !strings.HasPrefix(arg, "-"):
+		case name == "tags" && ok:
+			return splittags(value)
+		case name == "tags" && i+1 < len(args):
+			return splittags(args[i+1])
+		}
+	}
+	return nil
+}
+
+// settags returns go test args with their -tags flag replaced by one setting
+// tags and the tags set in GOFLAGS, if there are any.
+func settags(args []string, tags []string) ([]string, error) {
+	out, err := gen.BuildFlags(tags...)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < len(args); i++ {
+		name, _, ok := strings.Cut(strings.TrimLeft(args[i], "-"), "=")
+		switch {
+		case !strings.HasPrefix(args[i], "-") || name != "tags":
+			out = append(out, args[i])
+		case !ok:
+			i++ // Skip the value of -tags.
+		}
+	}
+	return out, nil
+}